@@ -0,0 +1,69 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// WalshFunction returns Walsh function k of length n in the given order as +1/-1 values.
+// In Sequency Order, Walsh function k changes sign exactly k times.
+func WalshFunction[T Signed](k, n int, order Order) ([]T, error) {
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+	if k < 0 || n <= k {
+		return nil, ErrOutOfRange
+	}
+
+	row := NaturalIndex(order, k, bits.Len(uint(n))-1)
+	w := make([]T, n)
+	for i := range w {
+		w[i] = hadamardSign[T](row, i)
+	}
+	return w, nil
+}
+
+// HadamardMatrix returns the n x n Sylvester Hadamard matrix, whose rows are in Natural Order.
+func HadamardMatrix[T Signed](n int) ([][]T, error) {
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+
+	h := make([][]T, n)
+	for i := range h {
+		h[i] = make([]T, n)
+		for j := range h[i] {
+			h[i][j] = hadamardSign[T](i, j)
+		}
+	}
+	return h, nil
+}
+
+// TransformReference applies Walsh-Hadamard Transform by O(n^2) matrix multiplication
+// and returns the coefficients in the given order. It is slow and intended as a reference
+// to verify the fast transforms against.
+func TransformReference[T Signed](in []T, order Order) ([]T, error) {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+
+	bitsLen := bits.Len(uint(n)) - 1
+	out := make([]T, n)
+	for k := range out {
+		row := NaturalIndex(order, k, bitsLen)
+		sum := T(0)
+		for i, v := range in {
+			sum += hadamardSign[T](row, i) * v
+		}
+		out[k] = sum
+	}
+	return out, nil
+}
+
+// hadamardSign is H[i][j] = (-1)^popcount(i & j) of the Sylvester Hadamard matrix.
+func hadamardSign[T Signed](i, j int) T {
+	if bits.OnesCount(uint(i&j))%2 == 1 {
+		return -1
+	}
+	return 1
+}
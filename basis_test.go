@@ -0,0 +1,158 @@
+package wht
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWalshFunction(t *testing.T) {
+	t.Run("sequency", func(tt *testing.T) {
+		for _, n := range []int{1, 2, 4, 8, 16, 64} {
+			for k := 0; k < n; k += 1 {
+				w, err := WalshFunction[int8](k, n, OrderSequency)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				changes := 0
+				for i := 1; i < n; i += 1 {
+					if w[i] != w[i-1] {
+						changes += 1
+					}
+				}
+				if changes != k {
+					tt.Errorf("n=%d k=%d: sign changes %d", n, k, changes)
+				}
+			}
+		}
+	})
+	t.Run("transform of basis", func(tt *testing.T) {
+		// transforming a Walsh function yields an impulse at k times n
+		for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+			for k := 0; k < 8; k += 1 {
+				w, err := WalshFunction[int16](k, 8, order)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				TransformOrder(w, order)
+				expect := make([]int16, 8)
+				expect[k] = 8
+				if cmp.Equal(w, expect) != true {
+					tt.Errorf("%s k=%d: %v != %v", order, k, w, expect)
+				}
+			}
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := WalshFunction[int16](8, 8, OrderSequency); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := WalshFunction[int16](0, 6, OrderSequency); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestHadamardMatrix(t *testing.T) {
+	h, err := HadamardMatrix[int8](4)
+	if err != nil {
+		t.Fatalf("no error: %+v", err)
+	}
+	expect := [][]int8{
+		{1, 1, 1, 1},
+		{1, -1, 1, -1},
+		{1, 1, -1, -1},
+		{1, -1, -1, 1},
+	}
+	if cmp.Equal(h, expect) != true {
+		t.Errorf("%v != %v", h, expect)
+	}
+
+	// H * H^T = n * I
+	n := 32
+	h32, err := HadamardMatrix[int32](n)
+	if err != nil {
+		t.Fatalf("no error: %+v", err)
+	}
+	for i := 0; i < n; i += 1 {
+		for j := 0; j < n; j += 1 {
+			sum := int32(0)
+			for k := 0; k < n; k += 1 {
+				sum += h32[i][k] * h32[j][k]
+			}
+			expect := int32(0)
+			if i == j {
+				expect = int32(n)
+			}
+			if sum != expect {
+				t.Errorf("[%d][%d] %d != %d", i, j, sum, expect)
+			}
+		}
+	}
+}
+
+func TestTransformReference(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func(n int) []int32 {
+		x := make([]int32, n)
+		for i := range x {
+			x[i] = r.Int32N(1<<12) - (1 << 11)
+		}
+		return x
+	}
+	reference := func(tt *testing.T, x []int32, order Order) []int32 {
+		y, err := TransformReference(x, order)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		return y
+	}
+
+	t.Run("fwht", func(tt *testing.T) {
+		for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+			for _, n := range []int{1, 2, 4, 8, 16, 32, 128} {
+				x := random(n)
+				expect := reference(tt, x, order)
+				TransformOrder(x, order)
+				if cmp.Equal(x, expect) != true {
+					tt.Errorf("%s n=%d: %v != %v", order, n, x, expect)
+				}
+			}
+		}
+	})
+	t.Run("inline", func(tt *testing.T) {
+		x4 := random(4)
+		if r := Transform4([4]int32(x4)); cmp.Equal(r[:], reference(tt, x4, OrderSequency)) != true {
+			tt.Errorf("Transform4 %v", r)
+		}
+		x8 := random(8)
+		if r := Transform8([8]int32(x8)); cmp.Equal(r[:], reference(tt, x8, OrderSequency)) != true {
+			tt.Errorf("Transform8 %v", r)
+		}
+		x16 := random(16)
+		if r := Transform16([16]int32(x16)); cmp.Equal(r[:], reference(tt, x16, OrderSequency)) != true {
+			tt.Errorf("Transform16 %v", r)
+		}
+		x32 := random(32)
+		if r := Transform32([32]int32(x32)); cmp.Equal(r[:], reference(tt, x32, OrderSequency)) != true {
+			tt.Errorf("Transform32 %v", r)
+		}
+		x64 := random(64)
+		if r := Transform64([64]int32(x64)); cmp.Equal(r[:], reference(tt, x64, OrderSequency)) != true {
+			tt.Errorf("Transform64 %v", r)
+		}
+	})
+	t.Run("float", func(tt *testing.T) {
+		x := []float64{0.5, -1.25, 3, 0, 2, 2, -0.75, 1}
+		expect, err := TransformReference(x, OrderSequency)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		Transform(x)
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+}
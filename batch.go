@@ -0,0 +1,77 @@
+package wht
+
+import (
+	"sync"
+)
+
+// TransformBatch applies Walsh-Hadamard Transform to count vectors of length n held in one buffer,
+// vector i starts at buf[i*stride]. Each vector is reordered to Sequency Order.
+// The vectors are split across the given number of goroutines; workers <= 1 runs on the calling goroutine.
+func TransformBatch[T Signed](buf []T, n, stride, count, workers int) error {
+	return batch(buf, n, stride, count, workers, (*Plan[T]).Forward)
+}
+
+// InvertBatch applies Inverse Walsh-Hadamard Transform to count vectors produced by TransformBatch.
+func InvertBatch[T Signed](buf []T, n, stride, count, workers int) error {
+	return batch(buf, n, stride, count, workers, (*Plan[T]).Inverse)
+}
+
+func batch[T Signed](buf []T, n, stride, count, workers int, fn func(*Plan[T], []T) error) error {
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if stride < n || count < 0 {
+		return ErrLengthMismatch
+	}
+	if count == 0 {
+		return nil
+	}
+	if len(buf) < (count-1)*stride+n {
+		return ErrLengthMismatch
+	}
+
+	if count < workers {
+		workers = count
+	}
+	if workers <= 1 {
+		return batchRange(buf, n, stride, 0, count, fn)
+	}
+
+	wg := new(sync.WaitGroup)
+	errs := make([]error, workers)
+	chunk := (count + workers - 1) / workers
+	for w := 0; w < workers; w += 1 {
+		from := w * chunk
+		to := min(from+chunk, count)
+		if to <= from {
+			break
+		}
+		wg.Add(1)
+		go func(w, from, to int) {
+			defer wg.Done()
+			errs[w] = batchRange(buf, n, stride, from, to, fn)
+		}(w, from, to)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func batchRange[T Signed](buf []T, n, stride, from, to int, fn func(*Plan[T], []T) error) error {
+	p, err := NewPlan[T](n, OrderSequency)
+	if err != nil {
+		return err
+	}
+	for i := from; i < to; i += 1 {
+		offset := i * stride
+		if err := fn(p, buf[offset:offset+n]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package wht
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformBatch(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, workers := range []int{0, 1, 3, 8, 100} {
+		n, stride, count := 16, 20, 37
+		buf := make([]int32, stride*count)
+		for i := range buf {
+			buf[i] = r.Int32N(512) - 256
+		}
+		orig := append([]int32(nil), buf...)
+
+		expect := append([]int32(nil), buf...)
+		for i := 0; i < count; i += 1 {
+			Transform(expect[i*stride : i*stride+n])
+		}
+
+		if err := TransformBatch(buf, n, stride, count, workers); err != nil {
+			t.Fatalf("workers=%d no error: %+v", workers, err)
+		}
+		if cmp.Equal(buf, expect) != true {
+			t.Errorf("workers=%d: %v != %v", workers, buf, expect)
+		}
+
+		if err := InvertBatch(buf, n, stride, count, workers); err != nil {
+			t.Fatalf("workers=%d no error: %+v", workers, err)
+		}
+		if cmp.Equal(buf, orig) != true {
+			t.Errorf("workers=%d: %v != %v", workers, buf, orig)
+		}
+	}
+	t.Run("tight", func(tt *testing.T) {
+		// last vector does not need the whole stride
+		buf := []int16{1, 0, 1, 0, 9, 9, 1, 1, 1, 1}
+		if err := TransformBatch(buf, 4, 6, 2, 2); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{2, 0, 0, 2, 9, 9, 4, 0, 0, 0}
+		if cmp.Equal(buf, expect) != true {
+			tt.Errorf("%v != %v", buf, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		buf := make([]int16, 64)
+		if err := TransformBatch(buf, 6, 8, 8, 1); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if err := TransformBatch(buf, 8, 4, 8, 1); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := TransformBatch(buf, 8, 8, 9, 1); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := TransformBatch(buf, 8, 8, 0, 1); err != nil {
+			tt.Errorf("no error: %+v", err)
+		}
+	})
+}
+
+func BenchmarkTransformBatch(b *testing.B) {
+	n, count := 32, 4096
+	buf := make([]int16, n*count)
+	b.Run("loop", func(tb *testing.B) {
+		for i := 0; i < tb.N; i += 1 {
+			for c := 0; c < count; c += 1 {
+				Transform(buf[c*n : c*n+n])
+			}
+		}
+	})
+	b.Run("batch", func(tb *testing.B) {
+		for i := 0; i < tb.N; i += 1 {
+			TransformBatch(buf, n, n, count, 1)
+		}
+	})
+	b.Run("parallel", func(tb *testing.B) {
+		for i := 0; i < tb.N; i += 1 {
+			TransformBatch(buf, n, n, count, 4)
+		}
+	})
+}
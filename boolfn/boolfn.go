@@ -0,0 +1,171 @@
+// Package boolfn provides cryptographic analysis of boolean functions and S-boxes based on the Walsh spectrum.
+package boolfn
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/octu0/wht"
+)
+
+const maxVars = 30
+
+var (
+	ErrInvalidVars    = errors.New("boolfn: number of variables must be 1 to 30")
+	ErrLengthMismatch = errors.New("boolfn: length mismatch")
+	ErrOutOfRange     = errors.New("boolfn: value out of range")
+)
+
+// TruthTable is a boolean function of n variables.
+// f(x) is bit x%64 of words[x/64], with variable i being bit i of x.
+type TruthTable struct {
+	vars  int
+	words []uint64
+}
+
+// NewTruthTable creates a TruthTable of vars variables from bit-packed words, which is copied.
+func NewTruthTable(vars int, words []uint64) (*TruthTable, error) {
+	if vars < 1 || maxVars < vars {
+		return nil, ErrInvalidVars
+	}
+	size := 1 << vars
+	if len(words) != (size+63)/64 {
+		return nil, ErrLengthMismatch
+	}
+
+	w := append([]uint64(nil), words...)
+	if size < 64 {
+		w[0] &= (1 << size) - 1
+	}
+	return &TruthTable{vars, w}, nil
+}
+
+// FromFunc creates a TruthTable of vars variables by evaluating f at every input.
+func FromFunc(vars int, f func(x uint32) bool) (*TruthTable, error) {
+	if vars < 1 || maxVars < vars {
+		return nil, ErrInvalidVars
+	}
+
+	size := 1 << vars
+	words := make([]uint64, (size+63)/64)
+	for x := 0; x < size; x += 1 {
+		if f(uint32(x)) {
+			words[x/64] |= 1 << (x % 64)
+		}
+	}
+	return &TruthTable{vars, words}, nil
+}
+
+// Vars returns the number of variables.
+func (t *TruthTable) Vars() int {
+	return t.vars
+}
+
+// Words returns the bit-packed truth table, which must not be modified.
+func (t *TruthTable) Words() []uint64 {
+	return t.words
+}
+
+// Eval returns f(x).
+func (t *TruthTable) Eval(x uint32) bool {
+	return (t.words[x/64]>>(x%64))&1 == 1
+}
+
+// Weight returns the Hamming weight, the number of inputs with f(x) = 1.
+func (t *TruthTable) Weight() int {
+	w := 0
+	for _, v := range t.words {
+		w += bits.OnesCount64(v)
+	}
+	return w
+}
+
+// IsBalanced reports whether f outputs 0 and 1 equally often.
+func (t *TruthTable) IsBalanced() bool {
+	return t.Weight() == 1<<(t.vars-1)
+}
+
+// WalshSpectrum returns W(a) = sum of (-1)^(f(x) ^ a.x) over every x, indexed by a.
+func (t *TruthTable) WalshSpectrum() []int32 {
+	size := 1 << t.vars
+	w := make([]int32, size)
+	for x := range w {
+		if t.Eval(uint32(x)) {
+			w[x] = -1
+		} else {
+			w[x] = 1
+		}
+	}
+	// Natural Order is indexed by a
+	wht.TransformOrder(w, wht.OrderNatural)
+	return w
+}
+
+// Nonlinearity returns the Hamming distance to the nearest affine function, 2^(n-1) - max|W(a)|/2.
+func (t *TruthTable) Nonlinearity() int {
+	return nonlinearity(t.vars, t.WalshSpectrum())
+}
+
+// CorrelationImmunity returns the largest m such that W(a) = 0 for every a with 1 <= wt(a) <= m,
+// so the output is statistically independent of any m inputs.
+func (t *TruthTable) CorrelationImmunity() int {
+	spectrum := t.WalshSpectrum()
+	order := t.vars
+	for a := 1; a < len(spectrum); a += 1 {
+		if spectrum[a] != 0 {
+			order = min(order, bits.OnesCount(uint(a))-1)
+		}
+	}
+	return order
+}
+
+// Resiliency returns the correlation immunity of a balanced function, or -1 if f is not balanced.
+func (t *TruthTable) Resiliency() int {
+	if t.IsBalanced() != true {
+		return -1
+	}
+	return t.CorrelationImmunity()
+}
+
+// ANF returns the algebraic normal form; bit u is the coefficient of the monomial of the variables in u.
+func (t *TruthTable) ANF() *TruthTable {
+	words := append([]uint64(nil), t.words...)
+	wht.MobiusGF2(words, 1<<t.vars)
+	return &TruthTable{t.vars, words}
+}
+
+// AlgebraicDegree returns the largest number of variables in a monomial of the ANF, or -1 for the zero function.
+func (t *TruthTable) AlgebraicDegree() int {
+	anf := t.ANF()
+	degree := -1
+	for i, w := range anf.words {
+		for ; w != 0; w &= w - 1 {
+			u := i*64 + bits.TrailingZeros64(w)
+			degree = max(degree, bits.OnesCount(uint(u)))
+		}
+	}
+	return degree
+}
+
+// Autocorrelation returns r(a) = sum of (-1)^(f(x) ^ f(x^a)) over every x, indexed by a.
+// It is computed as the inverse transform of the squared Walsh spectrum.
+func (t *TruthTable) Autocorrelation() []int64 {
+	spectrum := t.WalshSpectrum()
+	r := make([]int64, len(spectrum))
+	for i, v := range spectrum {
+		r[i] = int64(v) * int64(v)
+	}
+	wht.InvertOrder(r, wht.OrderNatural)
+	return r
+}
+
+func nonlinearity(vars int, spectrum []int32) int {
+	peak := int32(0)
+	for _, v := range spectrum {
+		if v < 0 {
+			v = -v
+		}
+		peak = max(peak, v)
+	}
+	return 1<<(vars-1) - int(peak/2)
+}
@@ -0,0 +1,182 @@
+package boolfn
+
+import (
+	"errors"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func dot(a, x uint32) int {
+	return bits.OnesCount32(a&x) % 2
+}
+
+func sign(b bool) int32 {
+	if b {
+		return -1
+	}
+	return 1
+}
+
+func naiveWalsh(t *TruthTable) []int32 {
+	size := 1 << t.Vars()
+	w := make([]int32, size)
+	for a := 0; a < size; a += 1 {
+		for x := 0; x < size; x += 1 {
+			if t.Eval(uint32(x)) != (dot(uint32(a), uint32(x)) == 1) {
+				w[a] -= 1
+			} else {
+				w[a] += 1
+			}
+		}
+	}
+	return w
+}
+
+func naiveAutocorrelation(t *TruthTable) []int64 {
+	size := 1 << t.Vars()
+	r := make([]int64, size)
+	for a := 0; a < size; a += 1 {
+		for x := 0; x < size; x += 1 {
+			r[a] += int64(sign(t.Eval(uint32(x)) != t.Eval(uint32(x^a))))
+		}
+	}
+	return r
+}
+
+func TestTruthTable(t *testing.T) {
+	t.Run("bent", func(tt *testing.T) {
+		// x0x1 ^ x2x3 ^ x4x5
+		f, err := FromFunc(6, func(x uint32) bool {
+			return (x&(x>>1)^(x>>2)&(x>>3)^(x>>4)&(x>>5))&1 == 1
+		})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for a, v := range f.WalshSpectrum() {
+			if v != 8 && v != -8 {
+				tt.Errorf("W(%d)=%d expect +/-8", a, v)
+			}
+		}
+		if nl := f.Nonlinearity(); nl != 28 {
+			tt.Errorf("nonlinearity %d != 28", nl)
+		}
+		if d := f.AlgebraicDegree(); d != 2 {
+			tt.Errorf("degree %d != 2", d)
+		}
+		if f.IsBalanced() {
+			tt.Errorf("bent function is not balanced")
+		}
+		if r := f.Resiliency(); r != -1 {
+			tt.Errorf("resiliency %d != -1", r)
+		}
+		r := f.Autocorrelation()
+		if r[0] != 64 {
+			tt.Errorf("r(0)=%d != 64", r[0])
+		}
+		for a := 1; a < len(r); a += 1 {
+			if r[a] != 0 {
+				tt.Errorf("r(%d)=%d expect 0", a, r[a])
+			}
+		}
+	})
+	t.Run("linear", func(tt *testing.T) {
+		// x0 ^ x1 ^ x2 is 2-resilient and affine
+		f, err := FromFunc(3, func(x uint32) bool {
+			return bits.OnesCount32(x)%2 == 1
+		})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if ci := f.CorrelationImmunity(); ci != 2 {
+			tt.Errorf("correlation immunity %d != 2", ci)
+		}
+		if r := f.Resiliency(); r != 2 {
+			tt.Errorf("resiliency %d != 2", r)
+		}
+		if nl := f.Nonlinearity(); nl != 0 {
+			tt.Errorf("nonlinearity %d != 0", nl)
+		}
+		if d := f.AlgebraicDegree(); d != 1 {
+			tt.Errorf("degree %d != 1", d)
+		}
+	})
+	t.Run("majority", func(tt *testing.T) {
+		f, err := NewTruthTable(3, []uint64{0b11101000})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if f.IsBalanced() != true {
+			tt.Errorf("majority is balanced")
+		}
+		if r := f.Resiliency(); r != 0 {
+			tt.Errorf("resiliency %d != 0", r)
+		}
+		if nl := f.Nonlinearity(); nl != 2 {
+			tt.Errorf("nonlinearity %d != 2", nl)
+		}
+		// x0x1 ^ x0x2 ^ x1x2
+		expect := []uint64{1<<0b011 | 1<<0b101 | 1<<0b110}
+		if anf := f.ANF().Words(); cmp.Equal(anf, expect) != true {
+			tt.Errorf("%b != %b", anf, expect)
+		}
+	})
+	t.Run("zero", func(tt *testing.T) {
+		f, err := NewTruthTable(2, []uint64{0})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if d := f.AlgebraicDegree(); d != -1 {
+			tt.Errorf("degree %d != -1", d)
+		}
+	})
+	t.Run("random", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		for _, vars := range []int{1, 2, 5, 6, 7, 9} {
+			words := make([]uint64, (1<<vars+63)/64)
+			for i := range words {
+				words[i] = r.Uint64()
+			}
+			f, err := NewTruthTable(vars, words)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			if w, expect := f.WalshSpectrum(), naiveWalsh(f); cmp.Equal(w, expect) != true {
+				tt.Errorf("vars=%d: %v != %v", vars, w, expect)
+			}
+			if a, expect := f.Autocorrelation(), naiveAutocorrelation(f); cmp.Equal(a, expect) != true {
+				tt.Errorf("vars=%d: %v != %v", vars, a, expect)
+			}
+
+			// ANF is an involution and evaluates back to f
+			anf := f.ANF()
+			if back := anf.ANF().Words(); cmp.Equal(back, f.Words()) != true {
+				tt.Errorf("vars=%d: %b != %b", vars, back, f.Words())
+			}
+			for x := uint32(0); x < 1<<vars; x += 1 {
+				v := false
+				for u := uint32(0); u < 1<<vars; u += 1 {
+					if u&x == u && anf.Eval(u) {
+						v = v != true
+					}
+				}
+				if v != f.Eval(x) {
+					tt.Errorf("vars=%d: f(%d) != ANF(%d)", vars, x, x)
+				}
+			}
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := NewTruthTable(0, nil); errors.Is(err, ErrInvalidVars) != true {
+			tt.Errorf("expect ErrInvalidVars: %+v", err)
+		}
+		if _, err := NewTruthTable(7, []uint64{0}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := FromFunc(31, nil); errors.Is(err, ErrInvalidVars) != true {
+			tt.Errorf("expect ErrInvalidVars: %+v", err)
+		}
+	})
+}
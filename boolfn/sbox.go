@@ -0,0 +1,80 @@
+package boolfn
+
+import (
+	"math/bits"
+)
+
+// SBox is a vectorial boolean function from in bits to out bits.
+type SBox struct {
+	in, out int
+	table   []uint32
+}
+
+// NewSBox creates an SBox from the lookup table of 2^in entries of out bits, which is copied.
+func NewSBox(in, out int, table []uint32) (*SBox, error) {
+	if in < 1 || maxVars < in || out < 1 || 32 < out {
+		return nil, ErrInvalidVars
+	}
+	if len(table) != 1<<in {
+		return nil, ErrLengthMismatch
+	}
+	for _, v := range table {
+		if out < 32 && 1<<out <= v {
+			return nil, ErrOutOfRange
+		}
+	}
+	return &SBox{in, out, append([]uint32(nil), table...)}, nil
+}
+
+// In returns the number of input bits.
+func (s *SBox) In() int {
+	return s.in
+}
+
+// Out returns the number of output bits.
+func (s *SBox) Out() int {
+	return s.out
+}
+
+// Component returns the component function x -> b.S(x).
+func (s *SBox) Component(b uint32) *TruthTable {
+	t, _ := FromFunc(s.in, func(x uint32) bool {
+		return bits.OnesCount32(b&s.table[x])%2 == 1
+	})
+	return t
+}
+
+// LAT returns the linear approximation table, LAT[a][b] = #{x : a.x = b.S(x)} - 2^(in-1).
+// Column b is half of the Walsh spectrum of the component function b.S.
+func (s *SBox) LAT() [][]int32 {
+	rows, cols := 1<<s.in, 1<<s.out
+	lat := make([][]int32, rows)
+	for a := range lat {
+		lat[a] = make([]int32, cols)
+	}
+	for b := 0; b < cols; b += 1 {
+		spectrum := s.Component(uint32(b)).WalshSpectrum()
+		for a, v := range spectrum {
+			lat[a][b] = v / 2
+		}
+	}
+	return lat
+}
+
+// Nonlinearity returns the smallest nonlinearity of the nonzero component functions.
+func (s *SBox) Nonlinearity() int {
+	nl := 1 << (s.in - 1)
+	for b := 1; b < 1<<s.out; b += 1 {
+		nl = min(nl, nonlinearity(s.in, s.Component(uint32(b)).WalshSpectrum()))
+	}
+	return nl
+}
+
+// AlgebraicDegree returns the largest algebraic degree of the coordinate functions.
+func (s *SBox) AlgebraicDegree() int {
+	degree := -1
+	for i := 0; i < s.out; i += 1 {
+		degree = max(degree, s.Component(1<<i).AlgebraicDegree())
+	}
+	return degree
+}
@@ -0,0 +1,84 @@
+package boolfn
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var present = []uint32{0xC, 0x5, 0x6, 0xB, 0x9, 0x0, 0xA, 0xD, 0x3, 0xE, 0xF, 0x8, 0x4, 0x7, 0x1, 0x2}
+
+func naiveLAT(table []uint32, in, out int) [][]int32 {
+	lat := make([][]int32, 1<<in)
+	for a := range lat {
+		lat[a] = make([]int32, 1<<out)
+		for b := range lat[a] {
+			count := int32(0)
+			for x, y := range table {
+				if dot(uint32(a), uint32(x)) == dot(uint32(b), y) {
+					count += 1
+				}
+			}
+			lat[a][b] = count - 1<<(in-1)
+		}
+	}
+	return lat
+}
+
+func TestSBox(t *testing.T) {
+	t.Run("present", func(tt *testing.T) {
+		s, err := NewSBox(4, 4, present)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		lat, expect := s.LAT(), naiveLAT(present, 4, 4)
+		if cmp.Equal(lat, expect) != true {
+			tt.Errorf("%v != %v", lat, expect)
+		}
+		peak := int32(0)
+		for a := 1; a < 16; a += 1 {
+			for b := 1; b < 16; b += 1 {
+				peak = max(peak, lat[a][b], -lat[a][b])
+			}
+		}
+		if peak != 4 {
+			tt.Errorf("max |LAT| %d != 4", peak)
+		}
+		if nl := s.Nonlinearity(); nl != 4 {
+			tt.Errorf("nonlinearity %d != 4", nl)
+		}
+		if d := s.AlgebraicDegree(); d != 3 {
+			tt.Errorf("degree %d != 3", d)
+		}
+		for b := uint32(1); b < 16; b += 1 {
+			if s.Component(b).IsBalanced() != true {
+				tt.Errorf("component %d of a permutation is balanced", b)
+			}
+		}
+	})
+	t.Run("nonsquare", func(tt *testing.T) {
+		table := make([]uint32, 32)
+		for x := range table {
+			table[x] = uint32(x*x+3*x+1) % 8
+		}
+		s, err := NewSBox(5, 3, table)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if lat, expect := s.LAT(), naiveLAT(table, 5, 3); cmp.Equal(lat, expect) != true {
+			tt.Errorf("%v != %v", lat, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := NewSBox(4, 4, present[:8]); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := NewSBox(4, 3, present); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := NewSBox(4, 0, present); errors.Is(err, ErrInvalidVars) != true {
+			tt.Errorf("expect ErrInvalidVars: %+v", err)
+		}
+	})
+}
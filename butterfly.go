@@ -0,0 +1,52 @@
+package wht
+
+import (
+	"unsafe"
+)
+
+// kernelMinHalf is the smallest butterfly span handed to the vector kernels,
+// the stages below it are cheaper in scalar code than a kernel call.
+const kernelMinHalf = 8
+
+func fwht[T Signed](in []T, n int) {
+	if n < 2 {
+		return
+	}
+
+	var zero T
+	switch any(zero).(type) {
+	case int16:
+		fwhtKernel(unsafe.Slice((*int16)(unsafe.Pointer(&in[0])), n), n, butterflyInt16)
+	case int32:
+		fwhtKernel(unsafe.Slice((*int32)(unsafe.Pointer(&in[0])), n), n, butterflyInt32)
+	case float32:
+		fwhtKernel(unsafe.Slice((*float32)(unsafe.Pointer(&in[0])), n), n, butterflyFloat32)
+	default:
+		fwhtGeneric(in, n)
+	}
+}
+
+func fwhtKernel[T int16 | int32 | float32](in []T, n int, kernel func(x, y []T)) {
+	half := 1
+	for ; half < n && half < kernelMinHalf; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			butterflyGeneric(in[i:i+half], in[i+half:i+half+half])
+		}
+	}
+	for ; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			kernel(in[i:i+half], in[i+half:i+half+half])
+		}
+	}
+}
+
+// butterflyGeneric sets x[i], y[i] = x[i] + y[i], x[i] - y[i].
+func butterflyGeneric[T Signed](x, y []T) {
+	y = y[:len(x)]
+	for i := range x {
+		a := x[i]
+		b := y[i]
+		x[i] = a + b
+		y[i] = a - b
+	}
+}
@@ -0,0 +1,85 @@
+//go:build amd64 && !purego
+
+package wht
+
+var useAVX2 = hasAVX2()
+
+//go:noescape
+func butterflyInt16SSE2(x, y []int16)
+
+//go:noescape
+func butterflyInt16AVX2(x, y []int16)
+
+//go:noescape
+func butterflyInt32SSE2(x, y []int32)
+
+//go:noescape
+func butterflyInt32AVX2(x, y []int32)
+
+//go:noescape
+func butterflyFloat32SSE2(x, y []float32)
+
+//go:noescape
+func butterflyFloat32AVX2(x, y []float32)
+
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+func xgetbv() (eax, edx uint32)
+
+func hasAVX2() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 7 {
+		return false
+	}
+	_, _, ecx1, _ := cpuid(1, 0)
+	osxsave := ecx1&(1<<27) != 0
+	avx := ecx1&(1<<28) != 0
+	if osxsave != true || avx != true {
+		return false
+	}
+	// OS saves XMM and YMM state
+	if eax, _ := xgetbv(); eax&0x6 != 0x6 {
+		return false
+	}
+	_, ebx7, _, _ := cpuid(7, 0)
+	return ebx7&(1<<5) != 0
+}
+
+func butterflyInt16(x, y []int16) {
+	y = y[:len(x)]
+	n := 0
+	if useAVX2 {
+		n = len(x) &^ 15
+		butterflyInt16AVX2(x[:n], y[:n])
+	} else {
+		n = len(x) &^ 7
+		butterflyInt16SSE2(x[:n], y[:n])
+	}
+	butterflyGeneric(x[n:], y[n:])
+}
+
+func butterflyInt32(x, y []int32) {
+	y = y[:len(x)]
+	n := 0
+	if useAVX2 {
+		n = len(x) &^ 7
+		butterflyInt32AVX2(x[:n], y[:n])
+	} else {
+		n = len(x) &^ 3
+		butterflyInt32SSE2(x[:n], y[:n])
+	}
+	butterflyGeneric(x[n:], y[n:])
+}
+
+func butterflyFloat32(x, y []float32) {
+	y = y[:len(x)]
+	n := 0
+	if useAVX2 {
+		n = len(x) &^ 7
+		butterflyFloat32AVX2(x[:n], y[:n])
+	} else {
+		n = len(x) &^ 3
+		butterflyFloat32SSE2(x[:n], y[:n])
+	}
+	butterflyGeneric(x[n:], y[n:])
+}
@@ -0,0 +1,166 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+// func butterflyInt16SSE2(x, y []int16)
+TEXT ·butterflyInt16SSE2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $3, CX
+	JZ   int16sse2done
+
+int16sse2loop:
+	MOVOU (SI), X0
+	MOVOU (DI), X1
+	MOVO  X0, X2
+	PADDW X1, X0
+	PSUBW X1, X2
+	MOVOU X0, (SI)
+	MOVOU X2, (DI)
+	ADDQ  $16, SI
+	ADDQ  $16, DI
+	DECQ  CX
+	JNZ   int16sse2loop
+
+int16sse2done:
+	RET
+
+// func butterflyInt16AVX2(x, y []int16)
+TEXT ·butterflyInt16AVX2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $4, CX
+	JZ   int16avx2done
+
+int16avx2loop:
+	VMOVDQU (SI), Y0
+	VMOVDQU (DI), Y1
+	VPADDW  Y1, Y0, Y2
+	VPSUBW  Y1, Y0, Y3
+	VMOVDQU Y2, (SI)
+	VMOVDQU Y3, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	DECQ    CX
+	JNZ     int16avx2loop
+	VZEROUPPER
+
+int16avx2done:
+	RET
+
+// func butterflyInt32SSE2(x, y []int32)
+TEXT ·butterflyInt32SSE2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $2, CX
+	JZ   int32sse2done
+
+int32sse2loop:
+	MOVOU (SI), X0
+	MOVOU (DI), X1
+	MOVO  X0, X2
+	PADDL X1, X0
+	PSUBL X1, X2
+	MOVOU X0, (SI)
+	MOVOU X2, (DI)
+	ADDQ  $16, SI
+	ADDQ  $16, DI
+	DECQ  CX
+	JNZ   int32sse2loop
+
+int32sse2done:
+	RET
+
+// func butterflyInt32AVX2(x, y []int32)
+TEXT ·butterflyInt32AVX2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $3, CX
+	JZ   int32avx2done
+
+int32avx2loop:
+	VMOVDQU (SI), Y0
+	VMOVDQU (DI), Y1
+	VPADDD  Y1, Y0, Y2
+	VPSUBD  Y1, Y0, Y3
+	VMOVDQU Y2, (SI)
+	VMOVDQU Y3, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	DECQ    CX
+	JNZ     int32avx2loop
+	VZEROUPPER
+
+int32avx2done:
+	RET
+
+// func butterflyFloat32SSE2(x, y []float32)
+TEXT ·butterflyFloat32SSE2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $2, CX
+	JZ   float32sse2done
+
+float32sse2loop:
+	MOVUPS (SI), X0
+	MOVUPS (DI), X1
+	MOVAPS X0, X2
+	ADDPS  X1, X0
+	SUBPS  X1, X2
+	MOVUPS X0, (SI)
+	MOVUPS X2, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DI
+	DECQ   CX
+	JNZ    float32sse2loop
+
+float32sse2done:
+	RET
+
+// func butterflyFloat32AVX2(x, y []float32)
+TEXT ·butterflyFloat32AVX2(SB), NOSPLIT, $0-48
+	MOVQ x_base+0(FP), SI
+	MOVQ x_len+8(FP), CX
+	MOVQ y_base+24(FP), DI
+	SHRQ $3, CX
+	JZ   float32avx2done
+
+float32avx2loop:
+	VMOVUPS (SI), Y0
+	VMOVUPS (DI), Y1
+	VADDPS  Y1, Y0, Y2
+	VSUBPS  Y1, Y0, Y3
+	VMOVUPS Y2, (SI)
+	VMOVUPS Y3, (DI)
+	ADDQ    $32, SI
+	ADDQ    $32, DI
+	DECQ    CX
+	JNZ     float32avx2loop
+	VZEROUPPER
+
+float32avx2done:
+	RET
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func xgetbv() (eax, edx uint32)
+TEXT ·xgetbv(SB), NOSPLIT, $0-8
+	MOVL $0, CX
+	XGETBV
+	MOVL AX, eax+0(FP)
+	MOVL DX, edx+4(FP)
+	RET
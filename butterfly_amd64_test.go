@@ -0,0 +1,20 @@
+//go:build amd64 && !purego
+
+package wht
+
+import (
+	"testing"
+)
+
+func TestButterflySSE2(t *testing.T) {
+	if useAVX2 != true {
+		t.Skip("AVX2 is not available, SSE2 is covered by TestButterfly")
+	}
+
+	useAVX2 = false
+	defer func() {
+		useAVX2 = true
+	}()
+	t.Run("kernel", testButterflyKernels)
+	t.Run("fwht", testFWHTKernels)
+}
@@ -0,0 +1,35 @@
+//go:build arm64 && !purego
+
+package wht
+
+// NEON (Advanced SIMD) is mandatory on arm64, no runtime detection is needed.
+
+//go:noescape
+func butterflyInt16NEON(x, y []int16)
+
+//go:noescape
+func butterflyInt32NEON(x, y []int32)
+
+//go:noescape
+func butterflyFloat32NEON(x, y []float32)
+
+func butterflyInt16(x, y []int16) {
+	y = y[:len(x)]
+	n := len(x) &^ 7
+	butterflyInt16NEON(x[:n], y[:n])
+	butterflyGeneric(x[n:], y[n:])
+}
+
+func butterflyInt32(x, y []int32) {
+	y = y[:len(x)]
+	n := len(x) &^ 3
+	butterflyInt32NEON(x[:n], y[:n])
+	butterflyGeneric(x[n:], y[n:])
+}
+
+func butterflyFloat32(x, y []float32) {
+	y = y[:len(x)]
+	n := len(x) &^ 3
+	butterflyFloat32NEON(x[:n], y[:n])
+	butterflyGeneric(x[n:], y[n:])
+}
@@ -0,0 +1,66 @@
+//go:build arm64 && !purego
+
+#include "textflag.h"
+
+// func butterflyInt16NEON(x, y []int16)
+TEXT ·butterflyInt16NEON(SB), NOSPLIT, $0-48
+	MOVD x_base+0(FP), R0
+	MOVD x_len+8(FP), R2
+	MOVD y_base+24(FP), R1
+	LSR  $3, R2, R2
+	CBZ  R2, int16done
+
+int16loop:
+	VLD1   (R0), [V0.H8]
+	VLD1   (R1), [V1.H8]
+	VADD   V1.H8, V0.H8, V2.H8
+	VSUB   V1.H8, V0.H8, V3.H8
+	VST1.P [V2.H8], 16(R0)
+	VST1.P [V3.H8], 16(R1)
+	SUBS   $1, R2, R2
+	BNE    int16loop
+
+int16done:
+	RET
+
+// func butterflyInt32NEON(x, y []int32)
+TEXT ·butterflyInt32NEON(SB), NOSPLIT, $0-48
+	MOVD x_base+0(FP), R0
+	MOVD x_len+8(FP), R2
+	MOVD y_base+24(FP), R1
+	LSR  $2, R2, R2
+	CBZ  R2, int32done
+
+int32loop:
+	VLD1   (R0), [V0.S4]
+	VLD1   (R1), [V1.S4]
+	VADD   V1.S4, V0.S4, V2.S4
+	VSUB   V1.S4, V0.S4, V3.S4
+	VST1.P [V2.S4], 16(R0)
+	VST1.P [V3.S4], 16(R1)
+	SUBS   $1, R2, R2
+	BNE    int32loop
+
+int32done:
+	RET
+
+// func butterflyFloat32NEON(x, y []float32)
+TEXT ·butterflyFloat32NEON(SB), NOSPLIT, $0-48
+	MOVD x_base+0(FP), R0
+	MOVD x_len+8(FP), R2
+	MOVD y_base+24(FP), R1
+	LSR  $2, R2, R2
+	CBZ  R2, float32done
+
+float32loop:
+	VLD1   (R0), [V0.S4]
+	VLD1   (R1), [V1.S4]
+	VFADD  V1.S4, V0.S4, V2.S4
+	VFSUB  V1.S4, V0.S4, V3.S4
+	VST1.P [V2.S4], 16(R0)
+	VST1.P [V3.S4], 16(R1)
+	SUBS   $1, R2, R2
+	BNE    float32loop
+
+float32done:
+	RET
@@ -0,0 +1,15 @@
+//go:build (!amd64 && !arm64) || purego
+
+package wht
+
+func butterflyInt16(x, y []int16) {
+	butterflyGeneric(x, y)
+}
+
+func butterflyInt32(x, y []int32) {
+	butterflyGeneric(x, y)
+}
+
+func butterflyFloat32(x, y []float32) {
+	butterflyGeneric(x, y)
+}
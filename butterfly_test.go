@@ -0,0 +1,127 @@
+package wht
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func testButterflyKernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("int16", func(tt *testing.T) {
+		for _, n := range []int{1, 7, 8, 15, 16, 17, 33, 100} {
+			x := make([]int16, n)
+			y := make([]int16, n)
+			for i := range x {
+				x[i] = int16(r.Uint32())
+				y[i] = int16(r.Uint32())
+			}
+			ex, ey := append([]int16(nil), x...), append([]int16(nil), y...)
+			butterflyInt16(x, y)
+			butterflyGeneric(ex, ey)
+			if cmp.Equal(x, ex) != true || cmp.Equal(y, ey) != true {
+				tt.Errorf("n=%d: %v %v != %v %v", n, x, y, ex, ey)
+			}
+		}
+	})
+	t.Run("int32", func(tt *testing.T) {
+		for _, n := range []int{1, 3, 4, 7, 8, 9, 33, 100} {
+			x := make([]int32, n)
+			y := make([]int32, n)
+			for i := range x {
+				x[i] = int32(r.Uint32())
+				y[i] = int32(r.Uint32())
+			}
+			ex, ey := append([]int32(nil), x...), append([]int32(nil), y...)
+			butterflyInt32(x, y)
+			butterflyGeneric(ex, ey)
+			if cmp.Equal(x, ex) != true || cmp.Equal(y, ey) != true {
+				tt.Errorf("n=%d: %v %v != %v %v", n, x, y, ex, ey)
+			}
+		}
+	})
+	t.Run("float32", func(tt *testing.T) {
+		for _, n := range []int{1, 3, 4, 7, 8, 9, 33, 100} {
+			x := make([]float32, n)
+			y := make([]float32, n)
+			for i := range x {
+				x[i] = float32(r.NormFloat64())
+				y[i] = float32(r.NormFloat64())
+			}
+			ex, ey := append([]float32(nil), x...), append([]float32(nil), y...)
+			butterflyFloat32(x, y)
+			butterflyGeneric(ex, ey)
+			if cmp.Equal(x, ex) != true || cmp.Equal(y, ey) != true {
+				tt.Errorf("n=%d: %v %v != %v %v", n, x, y, ex, ey)
+			}
+		}
+	})
+}
+
+func testFWHTKernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, n := range []int{2, 4, 8, 16, 32, 64, 256, 1024} {
+		t.Run("int16/"+strconv.Itoa(n), func(tt *testing.T) {
+			x := make([]int16, n)
+			for i := range x {
+				x[i] = int16(r.Uint32())
+			}
+			expect := append([]int16(nil), x...)
+			fwht(x, n)
+			fwhtGeneric(expect, n)
+			if cmp.Equal(x, expect) != true {
+				tt.Errorf("%v != %v", x, expect)
+			}
+		})
+		t.Run("int32/"+strconv.Itoa(n), func(tt *testing.T) {
+			x := make([]int32, n)
+			for i := range x {
+				x[i] = int32(r.Uint32())
+			}
+			expect := append([]int32(nil), x...)
+			fwht(x, n)
+			fwhtGeneric(expect, n)
+			if cmp.Equal(x, expect) != true {
+				tt.Errorf("%v != %v", x, expect)
+			}
+		})
+		t.Run("float32/"+strconv.Itoa(n), func(tt *testing.T) {
+			x := make([]float32, n)
+			for i := range x {
+				x[i] = float32(r.NormFloat64())
+			}
+			expect := append([]float32(nil), x...)
+			fwht(x, n)
+			fwhtGeneric(expect, n)
+			if cmp.Equal(x, expect) != true {
+				tt.Errorf("%v != %v", x, expect)
+			}
+		})
+	}
+}
+
+func TestButterfly(t *testing.T) {
+	t.Run("kernel", testButterflyKernels)
+	t.Run("fwht", testFWHTKernels)
+}
+
+func BenchmarkFWHT(b *testing.B) {
+	for _, n := range []int{32, 256, 4096} {
+		x := make([]int16, n)
+		for i := range x {
+			x[i] = int16(i)
+		}
+		b.Run("generic/"+strconv.Itoa(n), func(tb *testing.B) {
+			for i := 0; i < tb.N; i += 1 {
+				fwhtGeneric(x, n)
+			}
+		})
+		b.Run("kernel/"+strconv.Itoa(n), func(tb *testing.B) {
+			for i := 0; i < tb.N; i += 1 {
+				fwht(x, n)
+			}
+		})
+	}
+}
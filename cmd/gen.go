@@ -0,0 +1,230 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math/bits"
+	"os"
+)
+
+func main() {
+	out := bytes.NewBuffer(nil)
+	fmt.Fprintln(out, "// Code generated by cmd/gen.go; DO NOT EDIT.")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "package wht")
+
+	for _, n := range []int{32, 64} {
+		generateTransform(out, n)
+	}
+	for _, n := range []int{32, 64} {
+		generateInvert(out, n)
+	}
+
+	writeSource("unrolled.go", out)
+
+	// Natural and Dyadic Order kernels of every fixed size, used by Transform*Order and Invert*Order
+	out = bytes.NewBuffer(nil)
+	fmt.Fprintln(out, "// Code generated by cmd/gen.go; DO NOT EDIT.")
+	fmt.Fprintln(out, "")
+	fmt.Fprintln(out, "package wht")
+
+	orders := []struct {
+		name  string
+		label string
+		index func(nat, bitsLen int) int
+	}{
+		{"Natural", "Nat", func(nat, bitsLen int) int { return nat }},
+		{"Dyadic", "Dya", reverseBits},
+	}
+	for _, o := range orders {
+		for _, n := range []int{4, 8, 16, 32, 64} {
+			generateTransformOrder(out, n, o.name, o.label, o.index)
+		}
+		for _, n := range []int{4, 8, 16, 32, 64} {
+			generateInvertOrder(out, n, o.name, o.label, o.index)
+		}
+	}
+	writeSource("unrolled_order.go", out)
+}
+
+func writeSource(name string, out *bytes.Buffer) {
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		panic(err)
+	}
+	if err := os.WriteFile(name, src, 0644); err != nil {
+		panic(err)
+	}
+}
+
+func stageName(stage int) string {
+	return string(rune('a' + stage))
+}
+
+func generateStages(out *bytes.Buffer, n int, first func(i int) (string, string, string)) int {
+	stages := bits.Len(uint(n)) - 1
+
+	fmt.Fprintln(out, "")
+	for i := 0; i < n; i += 2 {
+		x, y, comment := first(i)
+		if comment != "" {
+			comment = " // " + comment
+		}
+		fmt.Fprintf(out, "\ta%d := %s + %s%s\n", i, x, y, comment)
+		fmt.Fprintf(out, "\ta%d := %s - %s\n", i+1, x, y)
+	}
+
+	for s := 1; s < stages-1; s += 1 {
+		half := 1 << s
+		prev, cur := stageName(s-1), stageName(s)
+
+		fmt.Fprintln(out, "")
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				fmt.Fprintf(out, "\t%s%d := %s%d + %s%d\n", cur, j, prev, j, prev, j+half)
+			}
+			for j := i; j < i+half; j += 1 {
+				fmt.Fprintf(out, "\t%s%d := %s%d - %s%d\n", cur, j+half, prev, j, prev, j+half)
+			}
+		}
+	}
+	return stages
+}
+
+func generateTransform(out *bytes.Buffer, n int) {
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "func Transform%d[T SignedInt](in [%d]T) [%d]T {", n, n, n)
+
+	stages := generateStages(out, n, func(i int) (string, string, string) {
+		return fmt.Sprintf("in[%d]", i), fmt.Sprintf("in[%d]", i+1), ""
+	})
+
+	last := stageName(stages - 2)
+	half := n / 2
+	bitsLen := stages
+	width := len(fmt.Sprint(n - 1))
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "\treturn [%d]T{\n", n)
+	for k := 0; k < n; k += 1 {
+		nat := naturalIndex(k, bitsLen)
+		if nat < half {
+			fmt.Fprintf(out, "\t\t%s%d + %s%d, // Seq %-*d (Nat %d)\n", last, nat, last, nat+half, width, k, nat)
+		} else {
+			fmt.Fprintf(out, "\t\t%s%d - %s%d, // Seq %-*d (Nat %d)\n", last, nat-half, last, nat, width, k, nat)
+		}
+	}
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "}")
+}
+
+func generateInvert(out *bytes.Buffer, n int) {
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "func Invert%d[T SignedInt](in [%d]T) [%d]T {\n", n, n, n)
+	fmt.Fprintf(out, "\t// Remap Sequency Order input to Natural Order for butterfly")
+
+	bitsLen := bits.Len(uint(n)) - 1
+	stages := generateStages(out, n, func(i int) (string, string, string) {
+		p := orderIndex(i, bitsLen)
+		q := orderIndex(i+1, bitsLen)
+		return fmt.Sprintf("in[%d]", p), fmt.Sprintf("in[%d]", q), fmt.Sprintf("in[%d]=Nat%d, in[%d]=Nat%d", p, i, q, i+1)
+	})
+
+	last := stageName(stages - 2)
+	half := n / 2
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "\treturn [%d]T{\n", n)
+	for i := 0; i < half; i += 1 {
+		fmt.Fprintf(out, "\t\t(%s%d + %s%d) >> %d,\n", last, i, last, i+half, stages)
+	}
+	for i := 0; i < half; i += 1 {
+		fmt.Fprintf(out, "\t\t(%s%d - %s%d) >> %d,\n", last, i, last, i+half, stages)
+	}
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "}")
+}
+
+// generateTransformOrder writes transform{n}{name}, whose output k is Natural Order coefficient nat with index(nat) = k.
+func generateTransformOrder(out *bytes.Buffer, n int, name, label string, index func(nat, bitsLen int) int) {
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "func transform%d%s[T SignedInt](in [%d]T) [%d]T {", n, name, n, n)
+
+	stages := generateStages(out, n, func(i int) (string, string, string) {
+		return fmt.Sprintf("in[%d]", i), fmt.Sprintf("in[%d]", i+1), ""
+	})
+
+	last := stageName(stages - 2)
+	half := n / 2
+	natAt := make([]int, n)
+	for nat := 0; nat < n; nat += 1 {
+		natAt[index(nat, stages)] = nat
+	}
+	width := len(fmt.Sprint(n - 1))
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "\treturn [%d]T{\n", n)
+	for k, nat := range natAt {
+		comment := fmt.Sprintf("%s %-*d (Nat %d)", label, width, k, nat)
+		if label == "Nat" {
+			comment = fmt.Sprintf("Nat %d", nat)
+		}
+		if nat < half {
+			fmt.Fprintf(out, "\t\t%s%d + %s%d, // %s\n", last, nat, last, nat+half, comment)
+		} else {
+			fmt.Fprintf(out, "\t\t%s%d - %s%d, // %s\n", last, nat-half, last, nat, comment)
+		}
+	}
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "}")
+}
+
+// generateInvertOrder writes invert{n}{name}, whose input k is Natural Order coefficient nat with index(nat) = k.
+func generateInvertOrder(out *bytes.Buffer, n int, name, label string, index func(nat, bitsLen int) int) {
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "func invert%d%s[T SignedInt](in [%d]T) [%d]T {", n, name, n, n)
+
+	bitsLen := bits.Len(uint(n)) - 1
+	stages := generateStages(out, n, func(i int) (string, string, string) {
+		p := index(i, bitsLen)
+		q := index(i+1, bitsLen)
+		if p == i && q == i+1 {
+			return fmt.Sprintf("in[%d]", p), fmt.Sprintf("in[%d]", q), ""
+		}
+		return fmt.Sprintf("in[%d]", p), fmt.Sprintf("in[%d]", q), fmt.Sprintf("in[%d]=Nat%d, in[%d]=Nat%d", p, i, q, i+1)
+	})
+
+	last := stageName(stages - 2)
+	half := n / 2
+	fmt.Fprintln(out, "")
+	fmt.Fprintf(out, "\treturn [%d]T{\n", n)
+	for i := 0; i < half; i += 1 {
+		fmt.Fprintf(out, "\t\t(%s%d + %s%d) >> %d,\n", last, i, last, i+half, stages)
+	}
+	for i := 0; i < half; i += 1 {
+		fmt.Fprintf(out, "\t\t(%s%d - %s%d) >> %d,\n", last, i, last, i+half, stages)
+	}
+	fmt.Fprintln(out, "\t}")
+	fmt.Fprintln(out, "}")
+}
+
+// reverseBits is the Dyadic Order index of Natural Order nat, and the Natural Order index of Dyadic Order k
+func reverseBits(nat, bitsLen int) int {
+	return int(bits.Reverse(uint(nat)) >> (bits.UintSize - bitsLen))
+}
+
+// naturalIndex is BitReverse(GrayCode(k)), the Natural Order index of Sequency Order k
+func naturalIndex(k, bitsLen int) int {
+	return int(bits.Reverse(uint(k^(k>>1))) >> (bits.UintSize - bitsLen))
+}
+
+// orderIndex is the Sequency Order index of Natural Order nat
+func orderIndex(nat, bitsLen int) int {
+	g := int(bits.Reverse(uint(nat)) >> (bits.UintSize - bitsLen))
+	k := g
+	for s := g >> 1; s != 0; s >>= 1 {
+		k ^= s
+	}
+	return k
+}
@@ -0,0 +1,69 @@
+package wht
+
+// XorConvolve returns the dyadic (XOR) convolution of a and b, c[k] = sum of a[i]*b[j] for i^j == k.
+// a and b must have the same length 2^n. The result is exact for integers as long as
+// the intermediate values, up to n times the result, fit in T; use XorConvolveMod otherwise.
+func XorConvolve[T Signed](a, b []T) ([]T, error) {
+	n := len(a)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, ErrLengthMismatch
+	}
+
+	fa := append([]T(nil), a...)
+	fb := append([]T(nil), b...)
+	fwht(fa, n)
+	fwht(fb, n)
+	for i := range fa {
+		fa[i] *= fb[i]
+	}
+	fwht(fa, n)
+	for i, v := range fa {
+		fa[i] = v / T(n)
+	}
+	return fa, nil
+}
+
+// XorCorrelate returns the dyadic cross-correlation of a and b, r[k] = sum of a[i]*b[i^k].
+// Since i^k == j is equivalent to i^j == k, it is the same as XorConvolve.
+func XorCorrelate[T Signed](a, b []T) ([]T, error) {
+	return XorConvolve(a, b)
+}
+
+// XorAutocorrelate returns the dyadic autocorrelation of a, r[k] = sum of a[i]*a[i^k].
+func XorAutocorrelate[T Signed](a []T) ([]T, error) {
+	return XorConvolve(a, a)
+}
+
+// XorConvolveMod returns the dyadic (XOR) convolution of a and b modulo mod.
+// mod must be odd so that n is invertible; the values of a and b are reduced modulo mod.
+func XorConvolveMod(a, b []uint64, mod uint64) ([]uint64, error) {
+	n := len(a)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, ErrLengthMismatch
+	}
+	if err := checkModulus(mod); err != nil {
+		return nil, err
+	}
+
+	fa := append([]uint64(nil), a...)
+	fb := append([]uint64(nil), b...)
+	reduceMod(fa, mod)
+	reduceMod(fb, mod)
+	fwhtMod(fa, n, mod)
+	fwhtMod(fb, n, mod)
+	for i := range fa {
+		fa[i] = mulMod(fa[i], fb[i], mod)
+	}
+	fwhtMod(fa, n, mod)
+	inv := invPowerOfTwoMod(n, mod)
+	for i, v := range fa {
+		fa[i] = mulMod(v, inv, mod)
+	}
+	return fa, nil
+}
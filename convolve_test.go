@@ -0,0 +1,131 @@
+package wht
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func naiveXorConvolve[T Signed](a, b []T) []T {
+	c := make([]T, len(a))
+	for i := range a {
+		for j := range b {
+			c[i^j] += a[i] * b[j]
+		}
+	}
+	return c
+}
+
+func TestXorConvolve(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	t.Run("int", func(tt *testing.T) {
+		for _, n := range []int{1, 2, 4, 16, 128} {
+			a := make([]int64, n)
+			b := make([]int64, n)
+			for i := range a {
+				a[i] = r.Int64N(2000) - 1000
+				b[i] = r.Int64N(2000) - 1000
+			}
+			c, err := XorConvolve(a, b)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			expect := naiveXorConvolve(a, b)
+			if cmp.Equal(c, expect) != true {
+				tt.Errorf("n=%d: %v != %v", n, c, expect)
+			}
+		}
+	})
+	t.Run("float", func(tt *testing.T) {
+		n := 64
+		a := make([]float64, n)
+		b := make([]float64, n)
+		for i := range a {
+			a[i] = r.NormFloat64()
+			b[i] = r.NormFloat64()
+		}
+		c, err := XorConvolve(a, b)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := naiveXorConvolve(a, b)
+		for i := range c {
+			if 1e-9 < math.Abs(c[i]-expect[i]) {
+				tt.Errorf("[%d] %v != %v", i, c[i], expect[i])
+			}
+		}
+	})
+	t.Run("correlate", func(tt *testing.T) {
+		a := []int32{1, 2, 3, 4, 5, 6, 7, 8}
+		b := []int32{0, 1, 0, -1, 2, 0, 0, 1}
+		c, err := XorCorrelate(a, b)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for k := range c {
+			sum := int32(0)
+			for i := range a {
+				sum += a[i] * b[i^k]
+			}
+			if c[k] != sum {
+				tt.Errorf("[%d] %d != %d", k, c[k], sum)
+			}
+		}
+
+		auto, err := XorAutocorrelate(a)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		// r[0] is the energy
+		if auto[0] != 204 {
+			tt.Errorf("energy %d", auto[0])
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := XorConvolve([]int16{1, 2}, []int16{1, 2, 3, 4}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := XorConvolve([]int16{1, 2, 3}, []int16{1, 2, 3}); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestXorConvolveMod(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, mod := range []uint64{998244353, 1000000007, (1 << 61) - 1, 15} {
+		n := 32
+		a := make([]uint64, n)
+		b := make([]uint64, n)
+		for i := range a {
+			a[i] = r.Uint64()
+			b[i] = r.Uint64()
+		}
+		c, err := XorConvolveMod(a, b, mod)
+		if err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+
+		m := new(big.Int).SetUint64(mod)
+		for k := range c {
+			sum := new(big.Int)
+			for i := range a {
+				p := new(big.Int).Mul(new(big.Int).SetUint64(a[i]), new(big.Int).SetUint64(b[i^k]))
+				sum.Add(sum, p)
+			}
+			sum.Mod(sum, m)
+			if sum.Uint64() != c[k] {
+				t.Errorf("mod=%d [%d] %d != %d", mod, k, c[k], sum.Uint64())
+			}
+		}
+	}
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := XorConvolveMod([]uint64{1, 2}, []uint64{3, 4}, 16); errors.Is(err, ErrInvalidModulus) != true {
+			tt.Errorf("expect ErrInvalidModulus: %+v", err)
+		}
+	})
+}
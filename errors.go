@@ -0,0 +1,20 @@
+package wht
+
+import (
+	"errors"
+)
+
+var (
+	ErrEmpty          = errors.New("wht: empty input")
+	ErrNotPowerOfTwo  = errors.New("wht: length must be a power of two")
+	ErrLengthMismatch = errors.New("wht: length mismatch")
+	ErrOverflow       = errors.New("wht: integer overflow")
+	ErrOutOfRange     = errors.New("wht: index out of range")
+	ErrNoHadamard     = errors.New("wht: no Hadamard matrix construction for the order")
+	ErrInvalidModulus = errors.New("wht: invalid modulus")
+	ErrDuplicateAxis  = errors.New("wht: axis appears twice")
+
+	ErrUnknownScanOrder = errors.New("wht: unknown scan order")
+	ErrInvalidScanTable = errors.New("wht: scan table is not a permutation")
+	ErrBuiltinScanOrder = errors.New("wht: built-in scan order cannot be replaced")
+)
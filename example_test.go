@@ -47,3 +47,19 @@ func ExampleZigzag() {
 	// [1 2 5 9 6 3 4 7 10 13 14 11 8 12 15 16]
 	// [[1 2 3 4] [5 6 7 8] [9 10 11 12] [13 14 15 16]]
 }
+
+func ExampleTransform2D() {
+	block := [][]int16{
+		{1, 1, 2, 2},
+		{1, 1, 2, 2},
+	}
+	wht.Transform2D(block)
+	fmt.Println(block)
+
+	wht.Invert2D(block)
+	fmt.Println(block)
+
+	// Output:
+	// [[12 -4 0 0] [0 0 0 0]]
+	// [[1 1 2 2] [1 1 2 2]]
+}
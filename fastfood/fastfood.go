@@ -0,0 +1,177 @@
+// Package fastfood implements Fastfood random features that approximate shift-invariant kernels in O(d log d).
+package fastfood
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+
+	"github.com/octu0/wht"
+)
+
+var (
+	ErrInvalidDim         = errors.New("fastfood: invalid dimension")
+	ErrInvalidLengthscale = errors.New("fastfood: lengthscale must be positive")
+	ErrUnknownKernel      = errors.New("fastfood: unknown kernel")
+	ErrLengthMismatch     = errors.New("fastfood: length mismatch")
+)
+
+type block[T wht.Float] struct {
+	signs []T   // B
+	perm  []int // Pi
+	gauss []T   // G
+	scale []T   // S with the normalisation folded in
+	phase []T
+}
+
+// Fastfood is a random feature map z such that z(x).z(y) approximates k(|x - y|).
+// Each block of n = 2^m >= d frequencies is V = S H G Pi H B, where B flips signs, H is the Walsh-Hadamard Transform,
+// Pi permutes, G is Gaussian and S rescales the rows so that V x is distributed as the kernel's spectral density.
+// The features are sqrt(2/D) cos(V x + b) with uniform phases b.
+// A Fastfood is not safe for concurrent use; create one per goroutine with the same seed.
+type Fastfood[T wht.Float] struct {
+	d, n, features int
+	kernel         Kernel
+	blocks         []block[T]
+	temp, perm     []T
+}
+
+// New creates a Fastfood map of the given number of features for vectors of dimension d.
+// The same seed always produces the same map.
+func New[T wht.Float](d, features int, kernel Kernel, lengthscale float64, seed uint64) (*Fastfood[T], error) {
+	if d < 1 || features < 1 {
+		return nil, ErrInvalidDim
+	}
+	if (lengthscale > 0) != true || math.IsInf(lengthscale, 1) {
+		return nil, ErrInvalidLengthscale
+	}
+	if kernel < RBF || Matern52 < kernel {
+		return nil, ErrUnknownKernel
+	}
+
+	n := 1
+	for n < d {
+		n <<= 1
+	}
+	r := rand.New(rand.NewPCG(seed, seed))
+	f := &Fastfood[T]{
+		d:        d,
+		n:        n,
+		features: features,
+		kernel:   kernel,
+		blocks:   make([]block[T], (features+n-1)/n),
+		temp:     make([]T, n),
+		perm:     make([]T, n),
+	}
+	for i := range f.blocks {
+		f.blocks[i] = newBlock[T](r, n, kernel, lengthscale)
+	}
+	return f, nil
+}
+
+func newBlock[T wht.Float](r *rand.Rand, n int, kernel Kernel, lengthscale float64) block[T] {
+	b := block[T]{
+		signs: make([]T, n),
+		perm:  r.Perm(n),
+		gauss: make([]T, n),
+		scale: make([]T, n),
+		phase: make([]T, n),
+	}
+	frobenius := 0.0
+	for i := 0; i < n; i += 1 {
+		if r.Uint64()&1 == 0 {
+			b.signs[i] = 1
+		} else {
+			b.signs[i] = -1
+		}
+		g := r.NormFloat64()
+		b.gauss[i] = T(g)
+		frobenius += g * g
+	}
+
+	// every row of H G Pi H B has norm sqrt(n) |G|, rescale it to the norm of a sample of the spectral density:
+	// chi(n) / l for RBF and chi(n) sqrt(2nu / chi^2(2nu)) / l for Matern (multivariate t)
+	norm := lengthscale * math.Sqrt(float64(n)*frobenius)
+	for i := 0; i < n; i += 1 {
+		s := math.Sqrt(chiSquare(r, n))
+		if dof := kernel.dof(); 0 < dof {
+			s *= math.Sqrt(float64(dof) / chiSquare(r, dof))
+		}
+		b.scale[i] = T(s / norm)
+		b.phase[i] = T(2 * math.Pi * r.Float64())
+	}
+	return b
+}
+
+func chiSquare(r *rand.Rand, dof int) float64 {
+	sum := 0.0
+	for i := 0; i < dof; i += 1 {
+		z := r.NormFloat64()
+		sum += z * z
+	}
+	return sum
+}
+
+// InDim returns the input dimension d.
+func (f *Fastfood[T]) InDim() int {
+	return f.d
+}
+
+// OutDim returns the number of features.
+func (f *Fastfood[T]) OutDim() int {
+	return f.features
+}
+
+// Kernel returns the approximated kernel.
+func (f *Fastfood[T]) Kernel() Kernel {
+	return f.kernel
+}
+
+// Map returns the features of in, which must have d elements.
+func (f *Fastfood[T]) Map(in []T) ([]T, error) {
+	out := make([]T, f.features)
+	if err := f.MapTo(in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MapTo writes the features of in to out, which must have d and OutDim elements. It does not allocate.
+func (f *Fastfood[T]) MapTo(in, out []T) error {
+	if len(in) != f.d || len(out) != f.features {
+		return ErrLengthMismatch
+	}
+
+	amplitude := math.Sqrt(2 / float64(f.features))
+	for i, b := range f.blocks {
+		for j, v := range in {
+			f.temp[j] = b.signs[j] * v
+		}
+		clear(f.temp[f.d:])
+		wht.TransformOrder(f.temp, wht.OrderNatural)
+		for j, p := range b.perm {
+			f.perm[j] = f.temp[p] * b.gauss[j]
+		}
+		wht.TransformOrder(f.perm, wht.OrderNatural)
+
+		offset := i * f.n
+		for j := 0; j < f.n && offset+j < f.features; j += 1 {
+			v := f.perm[j]*b.scale[j] + b.phase[j]
+			out[offset+j] = T(amplitude * math.Cos(float64(v)))
+		}
+	}
+	return nil
+}
+
+// MapBatch returns the features of every vector in batch.
+func (f *Fastfood[T]) MapBatch(batch [][]T) ([][]T, error) {
+	out := make([][]T, len(batch))
+	for i, in := range batch {
+		z, err := f.Map(in)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = z
+	}
+	return out, nil
+}
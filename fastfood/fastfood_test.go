@@ -0,0 +1,140 @@
+package fastfood
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func dotProduct[T float32 | float64](x, y []T) float64 {
+	sum := 0.0
+	for i := range x {
+		sum += float64(x[i]) * float64(y[i])
+	}
+	return sum
+}
+
+// pair returns x and y at distance r.
+func pair(rnd *rand.Rand, d int, r float64) ([]float64, []float64) {
+	x, delta := make([]float64, d), make([]float64, d)
+	norm := 0.0
+	for i := range x {
+		x[i] = rnd.NormFloat64()
+		delta[i] = rnd.NormFloat64()
+		norm += delta[i] * delta[i]
+	}
+	y := make([]float64, d)
+	for i := range y {
+		y[i] = x[i] + delta[i]*r/math.Sqrt(norm)
+	}
+	return x, y
+}
+
+func TestFastfood(t *testing.T) {
+	for _, k := range []Kernel{RBF, Matern12, Matern32, Matern52} {
+		t.Run(k.String(), func(tt *testing.T) {
+			const d, features, lengthscale = 20, 8192, 2.0
+			f, err := New[float64](d, features, k, lengthscale, 1)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			rnd := rand.New(rand.NewPCG(1, 2))
+			worst := 0.0
+			for r := 0.0; r <= 3*lengthscale; r += 0.25 {
+				x, y := pair(rnd, d, r)
+				zx, err := f.Map(x)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				zy, err := f.Map(y)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				approx, exact := dotProduct(zx, zy), k.Eval(r, lengthscale)
+				worst = max(worst, math.Abs(approx-exact))
+			}
+			if worst > 0.06 {
+				tt.Errorf("max error %v", worst)
+			}
+		})
+	}
+	t.Run("float32", func(tt *testing.T) {
+		f64, _ := New[float64](10, 100, RBF, 1, 5)
+		f32, _ := New[float32](10, 100, RBF, 1, 5)
+		x64 := []float64{0.1, -0.2, 0.3, 0.4, -0.5, 0.6, 0.7, -0.8, 0.9, 1.0}
+		x32 := make([]float32, len(x64))
+		for i, v := range x64 {
+			x32[i] = float32(v)
+		}
+		z64, _ := f64.Map(x64)
+		z32, _ := f32.Map(x32)
+		for i := range z64 {
+			if math.Abs(z64[i]-float64(z32[i])) > 1e-4 {
+				tt.Errorf("[%d] %v != %v", i, z64[i], z32[i])
+			}
+		}
+	})
+	t.Run("seed", func(tt *testing.T) {
+		x := []float64{1, 2, 3}
+		a, _ := New[float64](3, 10, Matern32, 1, 99)
+		b, _ := New[float64](3, 10, Matern32, 1, 99)
+		c, _ := New[float64](3, 10, Matern32, 1, 100)
+		za, _ := a.Map(x)
+		zb, _ := b.Map(x)
+		zc, _ := c.Map(x)
+		if cmp.Equal(za, zb) != true {
+			tt.Errorf("same seed: %v != %v", za, zb)
+		}
+		if cmp.Equal(za, zc) {
+			tt.Errorf("different seed: %v == %v", za, zc)
+		}
+	})
+	t.Run("batch", func(tt *testing.T) {
+		f, _ := New[float64](5, 20, RBF, 1, 3)
+		batch := [][]float64{{1, 2, 3, 4, 5}, {0, 0, 0, 0, 0}, {-1, 1, -1, 1, -1}}
+		out, err := f.MapBatch(batch)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i, x := range batch {
+			z, _ := f.Map(x)
+			if cmp.Equal(out[i], z) != true {
+				tt.Errorf("%v != %v", out[i], z)
+			}
+		}
+	})
+	t.Run("noalloc", func(tt *testing.T) {
+		f, _ := New[float32](30, 100, Matern52, 1, 3)
+		x, z := make([]float32, 30), make([]float32, 100)
+		allocs := testing.AllocsPerRun(100, func() {
+			f.MapTo(x, z)
+		})
+		if allocs != 0 {
+			tt.Errorf("expect no allocation: %v", allocs)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := New[float64](0, 10, RBF, 1, 0); errors.Is(err, ErrInvalidDim) != true {
+			tt.Errorf("expect ErrInvalidDim: %+v", err)
+		}
+		if _, err := New[float64](4, 0, RBF, 1, 0); errors.Is(err, ErrInvalidDim) != true {
+			tt.Errorf("expect ErrInvalidDim: %+v", err)
+		}
+		if _, err := New[float64](4, 10, RBF, 0, 0); errors.Is(err, ErrInvalidLengthscale) != true {
+			tt.Errorf("expect ErrInvalidLengthscale: %+v", err)
+		}
+		if _, err := New[float64](4, 10, RBF, math.NaN(), 0); errors.Is(err, ErrInvalidLengthscale) != true {
+			tt.Errorf("expect ErrInvalidLengthscale: %+v", err)
+		}
+		if _, err := New[float64](4, 10, Kernel(9), 1, 0); errors.Is(err, ErrUnknownKernel) != true {
+			tt.Errorf("expect ErrUnknownKernel: %+v", err)
+		}
+		f, _ := New[float64](4, 10, RBF, 1, 0)
+		if _, err := f.Map(make([]float64, 3)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,64 @@
+package fastfood
+
+import (
+	"math"
+)
+
+// Kernel is a shift-invariant kernel approximated by Fastfood features.
+type Kernel int
+
+const (
+	// RBF is the Gaussian kernel exp(-r^2 / 2l^2).
+	RBF Kernel = iota
+	// Matern12 is the Matern kernel with nu = 1/2, exp(-r / l).
+	Matern12
+	// Matern32 is the Matern kernel with nu = 3/2, (1 + sqrt(3)r/l) exp(-sqrt(3)r/l).
+	Matern32
+	// Matern52 is the Matern kernel with nu = 5/2, (1 + sqrt(5)r/l + 5r^2/3l^2) exp(-sqrt(5)r/l).
+	Matern52
+)
+
+func (k Kernel) String() string {
+	switch k {
+	case RBF:
+		return "rbf"
+	case Matern12:
+		return "matern12"
+	case Matern32:
+		return "matern32"
+	case Matern52:
+		return "matern52"
+	}
+	return "unknown"
+}
+
+// Eval returns the exact kernel value at distance r = |x - y| with the given lengthscale.
+func (k Kernel) Eval(r, lengthscale float64) float64 {
+	s := r / lengthscale
+	switch k {
+	case RBF:
+		return math.Exp(-s * s / 2)
+	case Matern12:
+		return math.Exp(-s)
+	case Matern32:
+		t := math.Sqrt(3) * s
+		return (1 + t) * math.Exp(-t)
+	case Matern52:
+		t := math.Sqrt(5) * s
+		return (1 + t + t*t/3) * math.Exp(-t)
+	}
+	return math.NaN()
+}
+
+// dof returns 2*nu, the degrees of freedom of the multivariate t spectral density of Matern kernels.
+func (k Kernel) dof() int {
+	switch k {
+	case Matern12:
+		return 1
+	case Matern32:
+		return 3
+	case Matern52:
+		return 5
+	}
+	return 0
+}
@@ -0,0 +1,40 @@
+package fastfood
+
+import (
+	"math"
+	"testing"
+)
+
+func TestKernel(t *testing.T) {
+	for _, k := range []Kernel{RBF, Matern12, Matern32, Matern52} {
+		t.Run(k.String(), func(tt *testing.T) {
+			if v := k.Eval(0, 1.5); math.Abs(v-1) > 1e-12 {
+				tt.Errorf("k(0)=%v expect 1", v)
+			}
+			prev := 1.0
+			for r := 0.1; r < 5; r += 0.1 {
+				v := k.Eval(r, 1.5)
+				if prev < v || v <= 0 {
+					tt.Errorf("k(%v)=%v not decreasing", r, v)
+				}
+				prev = v
+			}
+		})
+	}
+	t.Run("value", func(tt *testing.T) {
+		expect := map[Kernel]float64{
+			RBF:      math.Exp(-0.5),
+			Matern12: math.Exp(-1),
+			Matern32: (1 + math.Sqrt(3)) * math.Exp(-math.Sqrt(3)),
+			Matern52: (1 + math.Sqrt(5) + 5.0/3) * math.Exp(-math.Sqrt(5)),
+		}
+		for k, e := range expect {
+			if v := k.Eval(2, 2); math.Abs(v-e) > 1e-12 {
+				tt.Errorf("%s: %v != %v", k, v, e)
+			}
+		}
+		if v := Kernel(99).Eval(1, 1); math.IsNaN(v) != true {
+			tt.Errorf("unknown kernel: %v", v)
+		}
+	})
+}
@@ -0,0 +1,131 @@
+// Package hadamardcode implements the Hadamard code and the first-order Reed-Muller code
+// with maximum-likelihood decoding by the fast Walsh-Hadamard Transform.
+package hadamardcode
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/octu0/wht"
+)
+
+const maxOrder = 30
+
+var (
+	ErrInvalidOrder   = errors.New("hadamardcode: order must be 1 to 30")
+	ErrLengthMismatch = errors.New("hadamardcode: length mismatch")
+	ErrOutOfRange     = errors.New("hadamardcode: value out of range")
+)
+
+// Code is a Hadamard code of order k with codewords of n = 2^k bits.
+// Bit x of the codeword of message m is the parity of m & x, so bit i of m is the coefficient of bit i of x.
+// The augmented code, the first-order Reed-Muller code RM(1, k), carries one more message bit, bit k,
+// which complements the whole codeword; it doubles the codewords at the same minimum distance 2^(k-1).
+type Code struct {
+	k         int
+	augmented bool
+}
+
+// New creates a Code of order k, augmented to RM(1, k) or not.
+func New(k int, augmented bool) (*Code, error) {
+	if k < 1 || maxOrder < k {
+		return nil, ErrInvalidOrder
+	}
+	return &Code{k, augmented}, nil
+}
+
+// Len returns the codeword length n = 2^k.
+func (c *Code) Len() int {
+	return 1 << c.k
+}
+
+// MessageBits returns the number of message bits, k or k+1 for the augmented code.
+func (c *Code) MessageBits() int {
+	if c.augmented {
+		return c.k + 1
+	}
+	return c.k
+}
+
+// MinDistance returns the minimum Hamming distance d = 2^(k-1), so hard decoding corrects floor((d-1)/2) errors.
+func (c *Code) MinDistance() int {
+	return 1 << (c.k - 1)
+}
+
+// Encode returns the codeword of msg as one bit (0 or 1) per element.
+func (c *Code) Encode(msg uint64) ([]uint8, error) {
+	out := make([]uint8, c.Len())
+	if err := c.EncodeTo(msg, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EncodeTo writes the codeword of msg to out, which must have n elements.
+func (c *Code) EncodeTo(msg uint64, out []uint8) error {
+	if msg>>c.MessageBits() != 0 {
+		return ErrOutOfRange
+	}
+	if len(out) != c.Len() {
+		return ErrLengthMismatch
+	}
+
+	linear := msg & (1<<c.k - 1)
+	complement := uint8(msg >> c.k)
+	for x := range out {
+		out[x] = uint8(bits.OnesCount64(linear&uint64(x))&1) ^ complement
+	}
+	return nil
+}
+
+// DecodeHard returns the message whose codeword is nearest in Hamming distance to received (bits 0 or 1).
+// Ties resolve to the smallest message.
+func (c *Code) DecodeHard(received []uint8) (uint64, error) {
+	if len(received) != c.Len() {
+		return 0, ErrLengthMismatch
+	}
+
+	corr := make([]int32, len(received))
+	for x, b := range received {
+		switch b {
+		case 0:
+			corr[x] = 1
+		case 1:
+			corr[x] = -1
+		default:
+			return 0, ErrOutOfRange
+		}
+	}
+	return decode(c, corr), nil
+}
+
+// DecodeSoft returns the maximum-likelihood message for the received BPSK symbols under Gaussian noise,
+// where bit 0 is sent as +1 and bit 1 as -1; log-likelihood ratios with the same sign convention also work.
+// Ties resolve to the smallest message.
+func (c *Code) DecodeSoft(received []float64) (uint64, error) {
+	if len(received) != c.Len() {
+		return 0, ErrLengthMismatch
+	}
+
+	corr := append([]float64(nil), received...)
+	return decode(c, corr), nil
+}
+
+// decode picks the codeword of the largest correlation, W(a) = sum of corr[x] (-1)^(a.x) for every a at once.
+func decode[T int32 | float64](c *Code, corr []T) uint64 {
+	wht.TransformOrder(corr, wht.OrderNatural)
+
+	best, msg := corr[0], uint64(0)
+	pick := func(score T, candidate uint64) {
+		if best < score || (best == score && candidate < msg) {
+			best, msg = score, candidate
+		}
+	}
+	for a, v := range corr {
+		pick(v, uint64(a))
+		if c.augmented {
+			pick(-v, uint64(a)|1<<c.k)
+		}
+	}
+	return msg
+}
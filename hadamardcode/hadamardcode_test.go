@@ -0,0 +1,173 @@
+package hadamardcode
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func distance(a, b []uint8) int {
+	d := 0
+	for i := range a {
+		if a[i] != b[i] {
+			d += 1
+		}
+	}
+	return d
+}
+
+func TestCode(t *testing.T) {
+	t.Run("roundtrip", func(tt *testing.T) {
+		for _, augmented := range []bool{false, true} {
+			for k := 1; k <= 7; k += 1 {
+				c, err := New(k, augmented)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				codewords := make([][]uint8, 1<<c.MessageBits())
+				for msg := range codewords {
+					codewords[msg], err = c.Encode(uint64(msg))
+					if err != nil {
+						tt.Fatalf("no error: %+v", err)
+					}
+					got, err := c.DecodeHard(codewords[msg])
+					if err != nil {
+						tt.Fatalf("no error: %+v", err)
+					}
+					if got != uint64(msg) {
+						tt.Errorf("k=%d augmented=%v: %d != %d", k, augmented, got, msg)
+					}
+				}
+				minDist := c.Len()
+				for i := range codewords {
+					for j := i + 1; j < len(codewords); j += 1 {
+						minDist = min(minDist, distance(codewords[i], codewords[j]))
+					}
+				}
+				if minDist != c.MinDistance() {
+					tt.Errorf("k=%d augmented=%v: min distance %d != %d", k, augmented, minDist, c.MinDistance())
+				}
+			}
+		}
+	})
+	t.Run("reedmuller", func(tt *testing.T) {
+		// RM(1, 3) is the extended Hamming code [8, 4, 4]; 0b1011 is 1 ^ x0 ^ x1
+		c, _ := New(3, true)
+		word, _ := c.Encode(0b1011)
+		expect := []uint8{1, 0, 0, 1, 1, 0, 0, 1}
+		if distance(word, expect) != 0 {
+			tt.Errorf("%v != %v", word, expect)
+		}
+	})
+	t.Run("correct", func(tt *testing.T) {
+		// hard decoding corrects any floor((d-1)/2) errors
+		r := rand.New(rand.NewPCG(1, 2))
+		for _, k := range []int{3, 5, 8} {
+			c, _ := New(k, true)
+			for trial := 0; trial < 50; trial += 1 {
+				msg := r.Uint64N(1 << c.MessageBits())
+				word, _ := c.Encode(msg)
+				for _, x := range r.Perm(c.Len())[:(c.MinDistance()-1)/2] {
+					word[x] ^= 1
+				}
+				got, err := c.DecodeHard(word)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				if got != msg {
+					tt.Errorf("k=%d: %d != %d", k, got, msg)
+				}
+			}
+		}
+	})
+	t.Run("tie", func(tt *testing.T) {
+		// received 0111 is at distance 1 from the codewords of 1, 2, 3 and 4 (W(0) = -2, W(1..3) = 2)
+		c, _ := New(2, true)
+		got, err := c.DecodeHard([]uint8{0, 1, 1, 1})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if got != 1 {
+			tt.Errorf("%d != 1", got)
+		}
+		soft, err := c.DecodeSoft([]float64{1, -1, -1, -1})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if soft != 1 {
+			tt.Errorf("%d != 1", soft)
+		}
+	})
+	t.Run("ber", func(tt *testing.T) {
+		// BPSK over AWGN at Eb/N0 = 2dB, uncoded BER is Q(sqrt(2 Eb/N0)) = 0.0375
+		const k, trials, ebn0dB = 6, 3000, 2.0
+		c, _ := New(k, true)
+		rate := float64(c.MessageBits()) / float64(c.Len())
+		sigma := math.Sqrt(1 / (2 * rate * math.Pow(10, ebn0dB/10)))
+		uncoded := 0.5 * math.Erfc(math.Sqrt(math.Pow(10, ebn0dB/10)))
+
+		r := rand.New(rand.NewPCG(3, 4))
+		hardErrors, softErrors := 0, 0
+		symbols := make([]float64, c.Len())
+		hard := make([]uint8, c.Len())
+		for trial := 0; trial < trials; trial += 1 {
+			msg := r.Uint64N(1 << c.MessageBits())
+			word, _ := c.Encode(msg)
+			for x, b := range word {
+				symbols[x] = 1 - 2*float64(b) + sigma*r.NormFloat64()
+				hard[x] = 0
+				if symbols[x] < 0 {
+					hard[x] = 1
+				}
+			}
+			gotHard, err := c.DecodeHard(hard)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			gotSoft, err := c.DecodeSoft(symbols)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			hardErrors += bitsDiff(gotHard, msg)
+			softErrors += bitsDiff(gotSoft, msg)
+		}
+		total := float64(trials * c.MessageBits())
+		hardBER, softBER := float64(hardErrors)/total, float64(softErrors)/total
+		if uncoded/2 < softBER {
+			tt.Errorf("soft BER %v expect below %v", softBER, uncoded/2)
+		}
+		if hardBER < softBER {
+			tt.Errorf("soft BER %v expect below hard BER %v", softBER, hardBER)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := New(0, false); errors.Is(err, ErrInvalidOrder) != true {
+			tt.Errorf("expect ErrInvalidOrder: %+v", err)
+		}
+		if _, err := New(31, true); errors.Is(err, ErrInvalidOrder) != true {
+			tt.Errorf("expect ErrInvalidOrder: %+v", err)
+		}
+		c, _ := New(3, false)
+		if _, err := c.Encode(8); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if err := c.EncodeTo(1, make([]uint8, 4)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := c.DecodeHard([]uint8{0, 1, 2, 0, 0, 0, 0, 0}); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := c.DecodeSoft(make([]float64, 7)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
+
+func bitsDiff(a, b uint64) int {
+	n := 0
+	for d := a ^ b; d != 0; d &= d - 1 {
+		n += 1
+	}
+	return n
+}
@@ -0,0 +1,144 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+func checkModulus(mod uint64) error {
+	if mod < 3 || mod%2 == 0 {
+		return ErrInvalidModulus
+	}
+	return nil
+}
+
+func addMod(a, b, mod uint64) uint64 {
+	s, carry := bits.Add64(a, b, 0)
+	if carry != 0 || mod <= s {
+		s -= mod
+	}
+	return s
+}
+
+func subMod(a, b, mod uint64) uint64 {
+	if a < b {
+		return a + (mod - b)
+	}
+	return a - b
+}
+
+func mulMod(a, b, mod uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, mod)
+}
+
+// invPowerOfTwoMod returns the inverse of n = 2^k modulo an odd mod.
+func invPowerOfTwoMod(n int, mod uint64) uint64 {
+	half := mod/2 + 1 // inverse of 2
+	inv := uint64(1)
+	for ; 1 < n; n >>= 1 {
+		inv = mulMod(inv, half, mod)
+	}
+	return inv
+}
+
+func reduceMod(in []uint64, mod uint64) {
+	for i, v := range in {
+		if mod <= v {
+			in[i] = v % mod
+		}
+	}
+}
+
+func fwhtMod(in []uint64, n int, mod uint64) {
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				a := in[j]
+				b := in[j+half]
+				in[j] = addMod(a, b, mod)
+				in[j+half] = subMod(a, b, mod)
+			}
+		}
+	}
+}
+
+// TransformMod applies Walsh-Hadamard Transform modulo mod to a slice of any size 2^n.
+// Coefficients are exact residues however large the spectrum grows.
+// The values of in are reduced modulo mod, and the output is reordered to Sequency Order.
+func TransformMod(in []uint64, mod uint64) error {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if mod < 2 {
+		return ErrInvalidModulus
+	}
+
+	reduceMod(in, mod)
+	fwhtMod(in, n, mod)
+	Reorder(in, OrderNatural, OrderSequency)
+	return nil
+}
+
+// InvertMod applies Inverse Walsh-Hadamard Transform modulo mod, multiplying by the modular inverse of n.
+// mod must be odd for n to be invertible, typically a prime. Assumes the input is in Sequency Order.
+func InvertMod(in []uint64, mod uint64) error {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if err := checkModulus(mod); err != nil {
+		return err
+	}
+
+	reduceMod(in, mod)
+	Reorder(in, OrderSequency, OrderNatural)
+	fwhtMod(in, n, mod)
+	inv := invPowerOfTwoMod(n, mod)
+	for i, v := range in {
+		in[i] = mulMod(v, inv, mod)
+	}
+	return nil
+}
+
+// gf2Masks selects the bits whose index has bit s clear, for s = 1, 2, 4, 8, 16, 32.
+var gf2Masks = [6]uint64{
+	0x5555555555555555,
+	0x3333333333333333,
+	0x0F0F0F0F0F0F0F0F,
+	0x00FF00FF00FF00FF,
+	0x0000FFFF0000FFFF,
+	0x00000000FFFFFFFF,
+}
+
+// MobiusGF2 applies the binary Moebius (Reed-Muller) transform to n = 2^k bits packed in words,
+// bit i in words[i/64] at bit i%64. It is MobiusOr (and ZetaOr) modulo 2 on bit-packed input.
+// Over GF(2) the Hadamard butterfly (a+b, a-b) collapses to (a^b, a^b) and loses information,
+// so there is no Walsh-Hadamard Transform over GF(2); the Moebius butterfly (a, a^b) is used instead.
+// It is its own inverse, and converts a truth table to its algebraic normal form and back.
+func MobiusGF2(words []uint64, n int) error {
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if len(words) != (n+63)/64 {
+		return ErrLengthMismatch
+	}
+
+	for k, s := 0, 1; k < len(gf2Masks) && s < n; k, s = k+1, s<<1 {
+		m := gf2Masks[k]
+		for i, w := range words {
+			words[i] = w ^ ((w & m) << s)
+		}
+	}
+	if n < 64 {
+		words[0] &= (1 << n) - 1
+	}
+	for half := 1; half < len(words); half <<= 1 {
+		for i := 0; i < len(words); i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				words[j+half] ^= words[j]
+			}
+		}
+	}
+	return nil
+}
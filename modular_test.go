@@ -0,0 +1,161 @@
+package wht
+
+import (
+	"errors"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformMod(t *testing.T) {
+	t.Run("small", func(tt *testing.T) {
+		x := []uint64{1, 0, 1, 0, 0, 1, 1, 0}
+		if err := TransformMod(x, 7); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		// {4, 0, 0, 0, -2, 2, 2, 2} mod 7
+		expect1 := []uint64{4, 0, 0, 0, 5, 2, 2, 2}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		if err := InvertMod(x, 7); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect2 := []uint64{1, 0, 1, 0, 0, 1, 1, 0}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("large", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		mod := uint64(1<<61 - 1)
+		n := 64
+		x := make([]uint64, n)
+		for i := range x {
+			x[i] = r.Uint64N(mod)
+		}
+		orig := append([]uint64(nil), x...)
+		if err := TransformMod(x, mod); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+
+		m := new(big.Int).SetUint64(mod)
+		for k := 0; k < n; k += 1 {
+			w, _ := WalshFunction[int8](k, n, OrderSequency)
+			sum := new(big.Int)
+			for i, v := range orig {
+				if w[i] == 1 {
+					sum.Add(sum, new(big.Int).SetUint64(v))
+				} else {
+					sum.Sub(sum, new(big.Int).SetUint64(v))
+				}
+			}
+			sum.Mod(sum, m)
+			if sum.Uint64() != x[k] {
+				tt.Errorf("[%d] %d != %d", k, x[k], sum.Uint64())
+			}
+		}
+
+		if err := InvertMod(x, mod); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, orig) != true {
+			tt.Errorf("%v != %v", x, orig)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if err := TransformMod([]uint64{1, 2}, 1); errors.Is(err, ErrInvalidModulus) != true {
+			tt.Errorf("expect ErrInvalidModulus: %+v", err)
+		}
+		if err := InvertMod([]uint64{1, 2}, 8); errors.Is(err, ErrInvalidModulus) != true {
+			tt.Errorf("expect ErrInvalidModulus: %+v", err)
+		}
+		if err := TransformMod([]uint64{1, 2, 3}, 7); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestMobiusGF2(t *testing.T) {
+	naive := func(bitsIn []uint8) []uint8 {
+		// anf[u] = xor of f(x) for every x subset of u
+		out := make([]uint8, len(bitsIn))
+		for u := range out {
+			for x := range bitsIn {
+				if x&u == x {
+					out[u] ^= bitsIn[x]
+				}
+			}
+		}
+		return out
+	}
+	pack := func(bitsIn []uint8) []uint64 {
+		words := make([]uint64, (len(bitsIn)+63)/64)
+		for i, b := range bitsIn {
+			words[i/64] |= uint64(b) << (i % 64)
+		}
+		return words
+	}
+
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, n := range []int{1, 2, 8, 32, 64, 128, 1024} {
+		bitsIn := make([]uint8, n)
+		for i := range bitsIn {
+			bitsIn[i] = uint8(r.IntN(2))
+		}
+		words := pack(bitsIn)
+		if err := MobiusGF2(words, n); err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		expect := pack(naive(bitsIn))
+		if cmp.Equal(words, expect) != true {
+			t.Errorf("n=%d: %x != %x", n, words, expect)
+		}
+
+		// involution
+		if err := MobiusGF2(words, n); err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(words, pack(bitsIn)) != true {
+			t.Errorf("n=%d: not restored", n)
+		}
+	}
+	t.Run("mobius", func(tt *testing.T) {
+		// MobiusOr modulo 2
+		bitsIn := make([]uint8, 256)
+		counts := make([]int32, 256)
+		for i := range bitsIn {
+			bitsIn[i] = uint8(r.IntN(2))
+			counts[i] = int32(bitsIn[i])
+		}
+		words := pack(bitsIn)
+		if err := MobiusGF2(words, 256); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		MobiusOr(counts)
+		expect := make([]uint8, 256)
+		for i, v := range counts {
+			expect[i] = uint8(v & 1)
+		}
+		if cmp.Equal(words, pack(expect)) != true {
+			tt.Errorf("%x != %x", words, pack(expect))
+		}
+	})
+	t.Run("and", func(tt *testing.T) {
+		// f(x0, x1) = x0 & x1 has the single monomial x0x1
+		words := []uint64{0b1000}
+		if err := MobiusGF2(words, 4); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if words[0] != 0b1000 {
+			tt.Errorf("%b", words[0])
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if err := MobiusGF2(make([]uint64, 2), 64); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
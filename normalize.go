@@ -0,0 +1,55 @@
+package wht
+
+import (
+	"math"
+)
+
+// Normalization is the scaling of floating-point Walsh-Hadamard Transform.
+type Normalization uint8
+
+const (
+	// NormalizeInverse leaves the forward transform unscaled and scales the inverse by 1/n, same as Transform and Invert.
+	NormalizeInverse Normalization = iota
+	// NormalizeNone scales neither direction, so inverting a transform yields n times the input.
+	NormalizeNone
+	// NormalizeOrtho scales both directions by 1/sqrt(n), which preserves energy (Parseval's identity).
+	NormalizeOrtho
+)
+
+// TransformFloat applies Walsh-Hadamard Transform with the given normalisation to a slice of any size 2^n.
+// The output is reordered to Sequency Order.
+func TransformFloat[T Float](in []T, norm Normalization) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	Transform(in)
+	if norm == NormalizeOrtho {
+		scale(in, T(1/math.Sqrt(float64(n))))
+	}
+}
+
+// InvertFloat applies Inverse Walsh-Hadamard Transform with the given normalisation to a slice of any size 2^n.
+// Assumes the input is in Sequency Order.
+func InvertFloat[T Float](in []T, norm Normalization) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	Reorder(in, OrderSequency, OrderNatural)
+	fwht(in, n)
+	switch norm {
+	case NormalizeInverse:
+		scale(in, T(1/float64(n)))
+	case NormalizeOrtho:
+		scale(in, T(1/math.Sqrt(float64(n))))
+	}
+}
+
+func scale[T Float](in []T, s T) {
+	for i, v := range in {
+		in[i] = v * s
+	}
+}
@@ -0,0 +1,84 @@
+package wht
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestTransformFloat(t *testing.T) {
+	energy := func(x []float64) float64 {
+		e := 0.0
+		for _, v := range x {
+			e += v * v
+		}
+		return e
+	}
+	near := func(a, b float64) bool {
+		return math.Abs(a-b) <= 1e-9*math.Max(1, math.Abs(b))
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{1, 2, 8, 64, 1024} {
+		x := make([]float64, n)
+		for i := range x {
+			x[i] = r.NormFloat64()
+		}
+		e := energy(x)
+
+		t.Run("ortho", func(tt *testing.T) {
+			y := append([]float64(nil), x...)
+			TransformFloat(y, NormalizeOrtho)
+			if near(energy(y), e) != true {
+				tt.Errorf("n=%d: parseval %v != %v", n, energy(y), e)
+			}
+			InvertFloat(y, NormalizeOrtho)
+			for i := range y {
+				if near(y[i], x[i]) != true {
+					tt.Errorf("n=%d [%d] %v != %v", n, i, y[i], x[i])
+				}
+			}
+		})
+		t.Run("inverse", func(tt *testing.T) {
+			y := append([]float64(nil), x...)
+			TransformFloat(y, NormalizeInverse)
+			if near(energy(y), e*float64(n)) != true {
+				tt.Errorf("n=%d: parseval %v != %v", n, energy(y), e*float64(n))
+			}
+			z := append([]float64(nil), x...)
+			Transform(z)
+			for i := range y {
+				if y[i] != z[i] {
+					tt.Errorf("n=%d [%d] %v != %v", n, i, y[i], z[i])
+				}
+			}
+			InvertFloat(y, NormalizeInverse)
+			for i := range y {
+				if near(y[i], x[i]) != true {
+					tt.Errorf("n=%d [%d] %v != %v", n, i, y[i], x[i])
+				}
+			}
+		})
+		t.Run("none", func(tt *testing.T) {
+			y := append([]float64(nil), x...)
+			TransformFloat(y, NormalizeNone)
+			InvertFloat(y, NormalizeNone)
+			for i := range y {
+				if near(y[i], x[i]*float64(n)) != true {
+					tt.Errorf("n=%d [%d] %v != %v", n, i, y[i], x[i]*float64(n))
+				}
+			}
+		})
+	}
+	t.Run("float32", func(tt *testing.T) {
+		x := []float32{1, 0, 1, 0}
+		TransformFloat(x, NormalizeOrtho)
+		if x[0] != 1 || x[3] != 1 || x[1] != 0 || x[2] != 0 {
+			tt.Errorf("%v", x)
+		}
+		InvertFloat(x, NormalizeOrtho)
+		if x[0] != 1 || x[1] != 0 || x[2] != 1 || x[3] != 0 {
+			tt.Errorf("%v", x)
+		}
+	})
+}
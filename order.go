@@ -0,0 +1,240 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// Order is the ordering of Walsh-Hadamard coefficients.
+type Order uint8
+
+const (
+	// OrderSequency is Walsh ordering, sorted by the number of sign changes of the basis function.
+	OrderSequency Order = iota
+	// OrderNatural is Hadamard ordering, the output of the Sylvester butterfly as is.
+	OrderNatural
+	// OrderDyadic is Paley ordering, the bit reversal of Natural Order.
+	OrderDyadic
+)
+
+func (o Order) String() string {
+	switch o {
+	case OrderSequency:
+		return "sequency"
+	case OrderNatural:
+		return "natural"
+	case OrderDyadic:
+		return "dyadic"
+	}
+	return "unknown"
+}
+
+// TransformOrder applies Walsh-Hadamard Transform to a slice of any size 2^n.
+// The output is reordered to the given order; OrderNatural skips the permutation.
+func TransformOrder[T Signed](in []T, order Order) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	fwht(in, n)
+	Reorder(in, OrderNatural, order)
+}
+
+// InvertOrder applies Inverse Walsh-Hadamard Transform to a slice of any size 2^n.
+// Assumes the input is in the given order.
+func InvertOrder[T Signed](in []T, order Order) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	Reorder(in, order, OrderNatural)
+	fwht(in, n)
+	for i, v := range in {
+		in[i] = v / T(n)
+	}
+}
+
+// Reorder permutes coefficients of size 2^n in place from one order to another.
+func Reorder[T any](in []T, from, to Order) {
+	n := len(in)
+	if from == to || isPowerOfTwo(n) != true || n < 4 {
+		return
+	}
+
+	temp := make([]T, n)
+	bitsLen := bits.Len(uint(n)) - 1
+	for k := 0; k < n; k += 1 {
+		nat := NaturalIndex(to, k, bitsLen)
+		temp[k] = in[OrderIndex(from, nat, bitsLen)]
+	}
+	copy(in, temp)
+}
+
+// Permutation returns the table p of size n = 2^bitsLen such that
+// coefficient k in order 'to' is coefficient p[k] in order 'from'.
+func Permutation(n int, from, to Order) []int {
+	if isPowerOfTwo(n) != true {
+		return nil
+	}
+
+	p := make([]int, n)
+	bitsLen := bits.Len(uint(n)) - 1
+	for k := 0; k < n; k += 1 {
+		p[k] = OrderIndex(from, NaturalIndex(to, k, bitsLen), bitsLen)
+	}
+	return p
+}
+
+// NaturalIndex returns the Natural Order index of coefficient k in the given order
+// for a transform of size 2^bitsLen.
+func NaturalIndex(order Order, k, bitsLen int) int {
+	switch order {
+	case OrderSequency:
+		// BitReverse(GrayCode(k))
+		return reverseBits(k^(k>>1), bitsLen)
+	case OrderDyadic:
+		return reverseBits(k, bitsLen)
+	}
+	return k
+}
+
+// OrderIndex returns the index in the given order of the Natural Order coefficient nat
+// for a transform of size 2^bitsLen. It is the inverse of NaturalIndex.
+func OrderIndex(order Order, nat, bitsLen int) int {
+	switch order {
+	case OrderSequency:
+		// GrayDecode(BitReverse(nat))
+		g := reverseBits(nat, bitsLen)
+		k := g
+		for s := g >> 1; s != 0; s >>= 1 {
+			k ^= s
+		}
+		return k
+	case OrderDyadic:
+		return reverseBits(nat, bitsLen)
+	}
+	return nat
+}
+
+func reverseBits(v, bitsLen int) int {
+	if bitsLen < 1 {
+		return 0
+	}
+	return int(bits.Reverse(uint(v)) >> (bits.UintSize - bitsLen))
+}
+
+// Transform4Order is Transform4 with the output in the given order.
+// Natural and Dyadic Order use their own unrolled kernels, so no permutation is applied.
+func Transform4Order[T SignedInt](in [4]T, order Order) [4]T {
+	switch order {
+	case OrderSequency:
+		return Transform4(in)
+	case OrderDyadic:
+		return transform4Dyadic(in)
+	}
+	return transform4Natural(in)
+}
+
+// Transform8Order is Transform8 with the output in the given order.
+// Natural and Dyadic Order use their own unrolled kernels, so no permutation is applied.
+func Transform8Order[T SignedInt](in [8]T, order Order) [8]T {
+	switch order {
+	case OrderSequency:
+		return Transform8(in)
+	case OrderDyadic:
+		return transform8Dyadic(in)
+	}
+	return transform8Natural(in)
+}
+
+// Transform16Order is Transform16 with the output in the given order.
+// Natural and Dyadic Order use their own unrolled kernels, so no permutation is applied.
+func Transform16Order[T SignedInt](in [16]T, order Order) [16]T {
+	switch order {
+	case OrderSequency:
+		return Transform16(in)
+	case OrderDyadic:
+		return transform16Dyadic(in)
+	}
+	return transform16Natural(in)
+}
+
+// Transform32Order is Transform32 with the output in the given order.
+// Natural and Dyadic Order use their own unrolled kernels, so no permutation is applied.
+func Transform32Order[T SignedInt](in [32]T, order Order) [32]T {
+	switch order {
+	case OrderSequency:
+		return Transform32(in)
+	case OrderDyadic:
+		return transform32Dyadic(in)
+	}
+	return transform32Natural(in)
+}
+
+// Transform64Order is Transform64 with the output in the given order.
+// Natural and Dyadic Order use their own unrolled kernels, so no permutation is applied.
+func Transform64Order[T SignedInt](in [64]T, order Order) [64]T {
+	switch order {
+	case OrderSequency:
+		return Transform64(in)
+	case OrderDyadic:
+		return transform64Dyadic(in)
+	}
+	return transform64Natural(in)
+}
+
+// Invert4Order is Invert4 with the input in the given order.
+func Invert4Order[T SignedInt](in [4]T, order Order) [4]T {
+	switch order {
+	case OrderSequency:
+		return Invert4(in)
+	case OrderDyadic:
+		return invert4Dyadic(in)
+	}
+	return invert4Natural(in)
+}
+
+// Invert8Order is Invert8 with the input in the given order.
+func Invert8Order[T SignedInt](in [8]T, order Order) [8]T {
+	switch order {
+	case OrderSequency:
+		return Invert8(in)
+	case OrderDyadic:
+		return invert8Dyadic(in)
+	}
+	return invert8Natural(in)
+}
+
+// Invert16Order is Invert16 with the input in the given order.
+func Invert16Order[T SignedInt](in [16]T, order Order) [16]T {
+	switch order {
+	case OrderSequency:
+		return Invert16(in)
+	case OrderDyadic:
+		return invert16Dyadic(in)
+	}
+	return invert16Natural(in)
+}
+
+// Invert32Order is Invert32 with the input in the given order.
+func Invert32Order[T SignedInt](in [32]T, order Order) [32]T {
+	switch order {
+	case OrderSequency:
+		return Invert32(in)
+	case OrderDyadic:
+		return invert32Dyadic(in)
+	}
+	return invert32Natural(in)
+}
+
+// Invert64Order is Invert64 with the input in the given order.
+func Invert64Order[T SignedInt](in [64]T, order Order) [64]T {
+	switch order {
+	case OrderSequency:
+		return Invert64(in)
+	case OrderDyadic:
+		return invert64Dyadic(in)
+	}
+	return invert64Natural(in)
+}
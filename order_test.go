@@ -0,0 +1,184 @@
+package wht
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformOrder(t *testing.T) {
+	t.Run("natural", func(tt *testing.T) {
+		x := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		TransformOrder(x, OrderNatural)
+		expect1 := []int16{4, 2, 0, -2, 0, 2, 0, 2}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		InvertOrder(x, OrderNatural)
+		expect2 := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("dyadic", func(tt *testing.T) {
+		x := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		TransformOrder(x, OrderDyadic)
+		// Natural Order: {4, 2, 0, -2, 0, 2, 0, 2}
+		// bit reversal of index: 0, 4, 2, 6, 1, 5, 3, 7
+		expect1 := []int16{4, 0, 0, 0, 2, 2, -2, 2}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		InvertOrder(x, OrderDyadic)
+		expect2 := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("sequency", func(tt *testing.T) {
+		x := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		y := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		TransformOrder(x, OrderSequency)
+		Transform(y)
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+}
+
+func TestReorder(t *testing.T) {
+	orders := []Order{OrderSequency, OrderNatural, OrderDyadic}
+	for _, n := range []int{2, 4, 8, 16, 32} {
+		for _, from := range orders {
+			for _, to := range orders {
+				x := make([]int32, n)
+				for i := range x {
+					x[i] = int32(i*7 - 3)
+				}
+				TransformOrder(x, from)
+
+				y := make([]int32, n)
+				for i := range y {
+					y[i] = int32(i*7 - 3)
+				}
+				TransformOrder(y, to)
+
+				z := append([]int32(nil), x...)
+				Reorder(z, from, to)
+				if cmp.Equal(z, y) != true {
+					t.Errorf("n=%d %s->%s: %v != %v", n, from, to, z, y)
+				}
+
+				p := Permutation(n, from, to)
+				for k := range p {
+					if x[p[k]] != y[k] {
+						t.Errorf("n=%d %s->%s: permutation[%d]=%d", n, from, to, k, p[k])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestNaturalIndex(t *testing.T) {
+	for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+		for bitsLen := 0; bitsLen < 8; bitsLen += 1 {
+			for k := 0; k < 1<<bitsLen; k += 1 {
+				nat := NaturalIndex(order, k, bitsLen)
+				if v := OrderIndex(order, nat, bitsLen); v != k {
+					t.Errorf("%s bitsLen=%d: %d -> %d -> %d", order, bitsLen, k, nat, v)
+				}
+			}
+		}
+	}
+}
+
+func TestTransformInlineOrder(t *testing.T) {
+	for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+		t.Run("4/"+order.String(), func(tt *testing.T) {
+			x := [4]int16{138, 144, 149, 153}
+			r := Transform4Order(x, order)
+			expect := x
+			TransformOrder(expect[:], order)
+			if cmp.Equal(r, expect) != true {
+				tt.Errorf("%v != %v", r, expect)
+			}
+			y := Invert4Order(r, order)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("%v != %v", x, y)
+			}
+		})
+		t.Run("8/"+order.String(), func(tt *testing.T) {
+			x := [8]int16{20, 35, 41, 58, 66, 79, 81, 93}
+			r := Transform8Order(x, order)
+			expect := x
+			TransformOrder(expect[:], order)
+			if cmp.Equal(r, expect) != true {
+				tt.Errorf("%v != %v", r, expect)
+			}
+			y := Invert8Order(r, order)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("%v != %v", x, y)
+			}
+		})
+		t.Run("16/"+order.String(), func(tt *testing.T) {
+			x := [16]int16{
+				10, 20, 30, 40, 50, 60, 70, 80,
+				90, 85, 75, 65, 55, 45, 35, 25,
+			}
+			r := Transform16Order(x, order)
+			expect := x
+			TransformOrder(expect[:], order)
+			if cmp.Equal(r, expect) != true {
+				tt.Errorf("%v != %v", r, expect)
+			}
+			y := Invert16Order(r, order)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("%v != %v", x, y)
+			}
+		})
+		t.Run("32/"+order.String(), func(tt *testing.T) {
+			x := [32]int16{}
+			for i := range x {
+				x[i] = int16((i*29)%17 - 8)
+			}
+			r := Transform32Order(x, order)
+			expect := x
+			TransformOrder(expect[:], order)
+			if cmp.Equal(r, expect) != true {
+				tt.Errorf("%v != %v", r, expect)
+			}
+			y := Invert32Order(r, order)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("%v != %v", x, y)
+			}
+		})
+		t.Run("64/"+order.String(), func(tt *testing.T) {
+			x := [64]int16{}
+			for i := range x {
+				x[i] = int16((i*29)%17 - 8)
+			}
+			r := Transform64Order(x, order)
+			expect := x
+			TransformOrder(expect[:], order)
+			if cmp.Equal(r, expect) != true {
+				tt.Errorf("%v != %v", r, expect)
+			}
+			y := Invert64Order(r, order)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("%v != %v", x, y)
+			}
+		})
+	}
+}
+
+func BenchmarkTransform16Order(b *testing.B) {
+	x := [16]int16{10, 20, 30, 40, 50, 60, 70, 80, 90, 85, 75, 65, 55, 45, 35, 25}
+	for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+		b.Run(order.String(), func(tb *testing.B) {
+			for i := 0; i < tb.N; i += 1 {
+				x = Invert16Order(Transform16Order(x, order), order)
+			}
+		})
+	}
+}
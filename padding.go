@@ -0,0 +1,47 @@
+package wht
+
+// Padded is the transform of an input of arbitrary length, zero padded to the next 2^n.
+type Padded[T Signed] struct {
+	// Coeffs is the coefficients in Sequency Order, len(Coeffs) is 2^n.
+	Coeffs []T
+	// Length is the length of the original input.
+	Length int
+}
+
+// TransformPadded applies Walsh-Hadamard Transform to an input of any length.
+// The input is copied and zero padded to the next power of two, the input itself is not modified.
+func TransformPadded[T Signed](in []T) (Padded[T], error) {
+	if len(in) < 1 {
+		return Padded[T]{}, ErrEmpty
+	}
+
+	coeffs := make([]T, nextPowerOfTwo(len(in)))
+	copy(coeffs, in)
+	Transform(coeffs)
+	return Padded[T]{
+		Coeffs: coeffs,
+		Length: len(in),
+	}, nil
+}
+
+// InvertPadded applies Inverse Walsh-Hadamard Transform to p.Coeffs in place
+// and returns the samples of the original length.
+func InvertPadded[T Signed](p Padded[T]) ([]T, error) {
+	if err := checkLength(len(p.Coeffs)); err != nil {
+		return nil, err
+	}
+	if p.Length < 1 || len(p.Coeffs) < p.Length {
+		return nil, ErrLengthMismatch
+	}
+
+	Invert(p.Coeffs)
+	return p.Coeffs[:p.Length], nil
+}
+
+func nextPowerOfTwo(n int) int {
+	p := 1
+	for p < n {
+		p <<= 1
+	}
+	return p
+}
@@ -0,0 +1,59 @@
+package wht
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformPadded(t *testing.T) {
+	t.Run("6", func(tt *testing.T) {
+		x := []int32{3, 1, 4, 1, 5, 9}
+		p, err := TransformPadded(x)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if p.Length != 6 {
+			tt.Errorf("length = %d", p.Length)
+		}
+		expect1 := []int32{3, 1, 4, 1, 5, 9, 0, 0}
+		Transform(expect1)
+		if cmp.Equal(p.Coeffs, expect1) != true {
+			tt.Errorf("%v != %v", p.Coeffs, expect1)
+		}
+
+		y, err := InvertPadded(p)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect2 := []int32{3, 1, 4, 1, 5, 9}
+		if cmp.Equal(y, expect2) != true {
+			tt.Errorf("%v != %v", y, expect2)
+		}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("input modified: %v", x)
+		}
+	})
+	t.Run("power of two", func(tt *testing.T) {
+		p, err := TransformPadded([]int16{1, 0, 1, 0})
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{2, 0, 0, 2}
+		if cmp.Equal(p.Coeffs, expect) != true {
+			tt.Errorf("%v != %v", p.Coeffs, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := TransformPadded([]int16{}); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+		if _, err := InvertPadded(Padded[int16]{Coeffs: make([]int16, 6), Length: 5}); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if _, err := InvertPadded(Padded[int16]{Coeffs: make([]int16, 4), Length: 5}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,278 @@
+package wht
+
+// williamsonRows is the first rows of symmetric circulant Williamson matrices A, B, C, D of order m,
+// satisfying A^2 + B^2 + C^2 + D^2 = 4mI.
+var williamsonRows = map[int][4]string{
+	1:  {"+", "+", "+", "+"},
+	3:  {"+--", "+--", "+++", "+--"},
+	5:  {"+----", "+----", "++--+", "+-++-"},
+	7:  {"++-++-+", "+-+--+-", "+++--++", "+++--++"},
+	9:  {"++-+--+-+", "+---++---", "++++--+++", "++-+--+-+"},
+	11: {"+-+--++--+-", "+--+----+--", "++++----+++", "+-+++--+++-"},
+	13: {"++---+--+---+", "+---+-++-+---", "++++-+--+-+++", "++---+--+---+"},
+	15: {"++--++-++-++--+", "+--+++----+++--", "+++++-+--+-++++", "++-+-+----+-+-+"},
+	17: {"++----+-++-+----+", "+-+-+--+--+--+-+-", "+++++---++---++++", "+++-++--++--++-++"},
+	19: {"++---+-+-++-+-+---+", "+-+--+--+--+--+--+-", "++++++---++---+++++", "+++---+--++--+---++"},
+	21: {"+-+++-+---++---+-+++-", "-+--+-++--++--++-+--+", "+-++--++++++++++--++-", "++----+-++++++-+----+"},
+	23: {"+--++-+-+-++++-+-+-++--", "+++---++--++++--++---++", "+--+-+-++++++++++-+-+--", "-++-++---++++++---++-++"},
+	25: {"+-+--+-++--++++--++-+--+-", "--++-++--+-++++-+--++-++-", "+-++---++++++++++++---++-", "+-+---+---++++++---+---+-"},
+}
+
+// Hadamard returns a Hadamard matrix of order n, a +1/-1 matrix with H * H^T = nI.
+// It uses Sylvester construction for 2^k, Paley I, Paley II and Williamson constructions,
+// and doubles a smaller Hadamard matrix (Sylvester doubling) for the remaining multiples.
+// Orders without a known construction here return ErrNoHadamard.
+func Hadamard[T Signed](n int) ([][]T, error) {
+	switch {
+	case n < 1:
+		return nil, ErrEmpty
+	case n == 1 || n == 2:
+		return HadamardMatrix[T](n)
+	case n%4 != 0:
+		return nil, ErrNoHadamard
+	case isPowerOfTwo(n):
+		return HadamardMatrix[T](n)
+	case isPrime(n-1) && (n-1)%4 == 3:
+		return PaleyI[T](n - 1)
+	case isPrime(n/2-1) && (n/2-1)%4 == 1:
+		return PaleyII[T](n/2 - 1)
+	}
+	if _, ok := williamsonRows[n/4]; ok {
+		return Williamson[T](n / 4)
+	}
+
+	h, err := Hadamard[T](n / 2)
+	if err != nil {
+		return nil, err
+	}
+	return doubleHadamard(h), nil
+}
+
+// PaleyI returns the Hadamard matrix of order q+1 by Paley construction I.
+// q must be a prime with q = 3 (mod 4); prime powers are not supported.
+func PaleyI[T Signed](q int) ([][]T, error) {
+	if isPrime(q) != true || q%4 != 3 {
+		return nil, ErrNoHadamard
+	}
+
+	// H = I + S, S = [[0, 1^T], [-1, Q]] with Jacobsthal matrix Q
+	chi := quadraticCharacter(q)
+	n := q + 1
+	h := newMatrix[T](n)
+	for j := 1; j < n; j += 1 {
+		h[0][j] = 1
+		h[j][0] = -1
+	}
+	for i := 0; i < q; i += 1 {
+		for j := 0; j < q; j += 1 {
+			h[i+1][j+1] = T(chi[(j-i+q)%q])
+		}
+	}
+	for i := 0; i < n; i += 1 {
+		h[i][i] += 1
+	}
+	return h, nil
+}
+
+// PaleyII returns the Hadamard matrix of order 2(q+1) by Paley construction II.
+// q must be a prime with q = 1 (mod 4); prime powers are not supported.
+func PaleyII[T Signed](q int) ([][]T, error) {
+	if isPrime(q) != true || q%4 != 1 {
+		return nil, ErrNoHadamard
+	}
+
+	// symmetric conference matrix C = [[0, 1^T], [1, Q]]
+	chi := quadraticCharacter(q)
+	m := q + 1
+	c := make([][]int, m)
+	for i := range c {
+		c[i] = make([]int, m)
+	}
+	for j := 1; j < m; j += 1 {
+		c[0][j] = 1
+		c[j][0] = 1
+	}
+	for i := 0; i < q; i += 1 {
+		for j := 0; j < q; j += 1 {
+			c[i+1][j+1] = chi[(j-i+q)%q]
+		}
+	}
+
+	// replace 0 by [[1, -1], [-1, -1]] and +/-1 by +/-[[1, 1], [1, -1]]
+	h := newMatrix[T](2 * m)
+	for i := 0; i < m; i += 1 {
+		for j := 0; j < m; j += 1 {
+			switch v := T(c[i][j]); v {
+			case 0:
+				h[2*i][2*j], h[2*i][2*j+1] = 1, -1
+				h[2*i+1][2*j], h[2*i+1][2*j+1] = -1, -1
+			default:
+				h[2*i][2*j], h[2*i][2*j+1] = v, v
+				h[2*i+1][2*j], h[2*i+1][2*j+1] = v, -v
+			}
+		}
+	}
+	return h, nil
+}
+
+// Williamson returns the Hadamard matrix of order 4m by Williamson construction.
+// m must be an odd number up to 25.
+func Williamson[T Signed](m int) ([][]T, error) {
+	rows, ok := williamsonRows[m]
+	if ok != true {
+		return nil, ErrNoHadamard
+	}
+
+	abcd := [4][][]T{}
+	for i, row := range rows {
+		abcd[i] = circulant[T](row)
+	}
+	a, b, c, d := abcd[0], abcd[1], abcd[2], abcd[3]
+
+	// [ A  B  C  D]
+	// [-B  A -D  C]
+	// [-C  D  A -B]
+	// [-D -C  B  A]
+	blocks := [4][4][][]T{
+		{a, b, c, d},
+		{b, a, d, c},
+		{c, d, a, b},
+		{d, c, b, a},
+	}
+	signs := [4][4]T{
+		{1, 1, 1, 1},
+		{-1, 1, -1, 1},
+		{-1, 1, 1, -1},
+		{-1, -1, 1, 1},
+	}
+	h := newMatrix[T](4 * m)
+	for bi := 0; bi < 4; bi += 1 {
+		for bj := 0; bj < 4; bj += 1 {
+			for i := 0; i < m; i += 1 {
+				for j := 0; j < m; j += 1 {
+					h[bi*m+i][bj*m+j] = signs[bi][bj] * blocks[bi][bj][i][j]
+				}
+			}
+		}
+	}
+	return h, nil
+}
+
+// IsHadamard reports whether h is a square +1/-1 matrix with H * H^T = nI.
+func IsHadamard[T Signed](h [][]T) bool {
+	n := len(h)
+	if n < 1 {
+		return false
+	}
+	for _, row := range h {
+		if len(row) != n {
+			return false
+		}
+		for _, v := range row {
+			if v != 1 && v != -1 {
+				return false
+			}
+		}
+	}
+	for i := 0; i < n; i += 1 {
+		for j := i; j < n; j += 1 {
+			dot := 0
+			for k := 0; k < n; k += 1 {
+				if h[i][k] == h[j][k] {
+					dot += 1
+				} else {
+					dot -= 1
+				}
+			}
+			if (i == j && dot != n) || (i != j && dot != 0) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// MatrixTransform returns h * in, the transform of in by any Hadamard matrix h in O(n^2).
+func MatrixTransform[T Signed](h [][]T, in []T) ([]T, error) {
+	n := len(in)
+	if n < 1 {
+		return nil, ErrEmpty
+	}
+	if len(h) != n {
+		return nil, ErrLengthMismatch
+	}
+
+	out := make([]T, n)
+	for i, row := range h {
+		if len(row) != n {
+			return nil, ErrLengthMismatch
+		}
+		sum := T(0)
+		for j, v := range in {
+			sum += row[j] * v
+		}
+		out[i] = sum
+	}
+	return out, nil
+}
+
+func doubleHadamard[T Signed](h [][]T) [][]T {
+	n := len(h)
+	d := newMatrix[T](2 * n)
+	for i := 0; i < n; i += 1 {
+		for j := 0; j < n; j += 1 {
+			d[i][j] = h[i][j]
+			d[i][j+n] = h[i][j]
+			d[i+n][j] = h[i][j]
+			d[i+n][j+n] = -h[i][j]
+		}
+	}
+	return d
+}
+
+func circulant[T Signed](row string) [][]T {
+	m := len(row)
+	c := newMatrix[T](m)
+	for i := 0; i < m; i += 1 {
+		for j := 0; j < m; j += 1 {
+			if row[(j-i+m)%m] == '+' {
+				c[i][j] = 1
+			} else {
+				c[i][j] = -1
+			}
+		}
+	}
+	return c
+}
+
+func newMatrix[T Signed](n int) [][]T {
+	m := make([][]T, n)
+	for i := range m {
+		m[i] = make([]T, n)
+	}
+	return m
+}
+
+// quadraticCharacter returns chi(x) for x in [0, q): 0 for 0, 1 for quadratic residues and -1 otherwise.
+func quadraticCharacter(q int) []int {
+	chi := make([]int, q)
+	for i := 1; i < q; i += 1 {
+		chi[i] = -1
+	}
+	for x := 1; x < q; x += 1 {
+		chi[(x*x)%q] = 1
+	}
+	return chi
+}
+
+func isPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	for d := 2; d*d <= n; d += 1 {
+		if n%d == 0 {
+			return false
+		}
+	}
+	return true
+}
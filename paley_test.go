@@ -0,0 +1,143 @@
+package wht
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestHadamard(t *testing.T) {
+	t.Run("orders", func(tt *testing.T) {
+		for n := 4; n <= 100; n += 4 {
+			h, err := Hadamard[int8](n)
+			if err != nil {
+				tt.Errorf("n=%d no error: %+v", n, err)
+				continue
+			}
+			if len(h) != n || IsHadamard(h) != true {
+				tt.Errorf("n=%d is not Hadamard", n)
+			}
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := Hadamard[int8](6); errors.Is(err, ErrNoHadamard) != true {
+			tt.Errorf("expect ErrNoHadamard: %+v", err)
+		}
+		if _, err := Hadamard[int8](0); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
+
+func TestPaley(t *testing.T) {
+	t.Run("I", func(tt *testing.T) {
+		for _, q := range []int{3, 7, 11, 19, 23, 31, 43} {
+			h, err := PaleyI[int16](q)
+			if err != nil {
+				tt.Fatalf("q=%d no error: %+v", q, err)
+			}
+			if len(h) != q+1 || IsHadamard(h) != true {
+				tt.Errorf("q=%d is not Hadamard", q)
+			}
+		}
+		if _, err := PaleyI[int16](13); errors.Is(err, ErrNoHadamard) != true {
+			tt.Errorf("expect ErrNoHadamard: %+v", err)
+		}
+		if _, err := PaleyI[int16](27); errors.Is(err, ErrNoHadamard) != true {
+			tt.Errorf("expect ErrNoHadamard: %+v", err)
+		}
+	})
+	t.Run("II", func(tt *testing.T) {
+		for _, q := range []int{5, 13, 17, 29, 37} {
+			h, err := PaleyII[int16](q)
+			if err != nil {
+				tt.Fatalf("q=%d no error: %+v", q, err)
+			}
+			if len(h) != 2*(q+1) || IsHadamard(h) != true {
+				tt.Errorf("q=%d is not Hadamard", q)
+			}
+		}
+		if _, err := PaleyII[int16](7); errors.Is(err, ErrNoHadamard) != true {
+			tt.Errorf("expect ErrNoHadamard: %+v", err)
+		}
+	})
+	t.Run("Williamson", func(tt *testing.T) {
+		for m := 1; m <= 25; m += 2 {
+			h, err := Williamson[int16](m)
+			if err != nil {
+				tt.Fatalf("m=%d no error: %+v", m, err)
+			}
+			if len(h) != 4*m || IsHadamard(h) != true {
+				tt.Errorf("m=%d is not Hadamard", m)
+			}
+		}
+		if _, err := Williamson[int16](27); errors.Is(err, ErrNoHadamard) != true {
+			tt.Errorf("expect ErrNoHadamard: %+v", err)
+		}
+	})
+}
+
+func TestIsHadamard(t *testing.T) {
+	if IsHadamard([][]int8{{1, 1}, {1, 1}}) {
+		t.Errorf("not orthogonal")
+	}
+	if IsHadamard([][]int8{{1, 0}, {0, 1}}) {
+		t.Errorf("not +1/-1")
+	}
+	if IsHadamard([][]int8{{1, 1}}) {
+		t.Errorf("not square")
+	}
+}
+
+func TestMatrixTransform(t *testing.T) {
+	t.Run("sylvester", func(tt *testing.T) {
+		h, err := HadamardMatrix[int32](8)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		x := []int32{1, 0, 1, 0, 0, 1, 1, 0}
+		y, err := MatrixTransform(h, x)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		TransformOrder(x, OrderNatural)
+		if cmp.Equal(y, x) != true {
+			tt.Errorf("%v != %v", y, x)
+		}
+	})
+	t.Run("12", func(tt *testing.T) {
+		h, err := Hadamard[int32](12)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		x := []int32{3, -1, 4, 1, -5, 9, 2, -6, 5, 3, -5, 8}
+		y, err := MatrixTransform(h, x)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+
+		// H^T * H * x = n * x
+		ht := newMatrix[int32](12)
+		for i := range h {
+			for j := range h[i] {
+				ht[j][i] = h[i][j]
+			}
+		}
+		z, err := MatrixTransform(ht, y)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i := range z {
+			if z[i] != 12*x[i] {
+				tt.Errorf("[%d] %d != %d", i, z[i], 12*x[i])
+			}
+		}
+	})
+	t.Run("mismatch", func(tt *testing.T) {
+		h, _ := Hadamard[int32](12)
+		if _, err := MatrixTransform(h, make([]int32, 8)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,81 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// Plan is a Walsh-Hadamard Transform precomputed for a fixed size 2^n.
+// It caches the permutation table and the scratch space, so Forward and Inverse never allocate.
+// A Plan is not safe for concurrent use; create one Plan per goroutine.
+type Plan[T Signed] struct {
+	n     int
+	order Order
+	perm  []int // coefficient k in order is perm[k] in Natural Order
+	temp  []T
+}
+
+// NewPlan creates a Plan for slices of length n with the coefficients in the given order.
+func NewPlan[T Signed](n int, order Order) (*Plan[T], error) {
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+
+	p := &Plan[T]{
+		n:     n,
+		order: order,
+	}
+	if order != OrderNatural {
+		bitsLen := bits.Len(uint(n)) - 1
+		p.perm = make([]int, n)
+		p.temp = make([]T, n)
+		for k := 0; k < n; k += 1 {
+			p.perm[k] = NaturalIndex(order, k, bitsLen)
+		}
+	}
+	return p, nil
+}
+
+// Len returns the length of slices the Plan transforms.
+func (p *Plan[T]) Len() int {
+	return p.n
+}
+
+// Order returns the order of coefficients the Plan produces and consumes.
+func (p *Plan[T]) Order() Order {
+	return p.order
+}
+
+// Forward applies Walsh-Hadamard Transform to in, in place.
+func (p *Plan[T]) Forward(in []T) error {
+	if len(in) != p.n {
+		return ErrLengthMismatch
+	}
+
+	fwht(in, p.n)
+	if p.perm != nil {
+		for k, nat := range p.perm {
+			p.temp[k] = in[nat]
+		}
+		copy(in, p.temp)
+	}
+	return nil
+}
+
+// Inverse applies Inverse Walsh-Hadamard Transform to in, in place.
+func (p *Plan[T]) Inverse(in []T) error {
+	if len(in) != p.n {
+		return ErrLengthMismatch
+	}
+
+	if p.perm != nil {
+		for k, nat := range p.perm {
+			p.temp[nat] = in[k]
+		}
+		copy(in, p.temp)
+	}
+	fwht(in, p.n)
+	for i, v := range in {
+		in[i] = v / T(p.n)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package wht
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlan(t *testing.T) {
+	for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+		t.Run(order.String(), func(tt *testing.T) {
+			for _, n := range []int{1, 2, 4, 8, 64, 256} {
+				p, err := NewPlan[int32](n, order)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+
+				x := make([]int32, n)
+				expect := make([]int32, n)
+				for i := range x {
+					x[i] = int32((i*37)%11 - 5)
+					expect[i] = x[i]
+				}
+				orig := append([]int32(nil), x...)
+
+				if err := p.Forward(x); err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				TransformOrder(expect, order)
+				if cmp.Equal(x, expect) != true {
+					tt.Errorf("n=%d: %v != %v", n, x, expect)
+				}
+
+				if err := p.Inverse(x); err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				if cmp.Equal(x, orig) != true {
+					tt.Errorf("n=%d: %v != %v", n, x, orig)
+				}
+			}
+		})
+	}
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := NewPlan[int16](12, OrderSequency); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if _, err := NewPlan[int16](0, OrderSequency); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+
+		p, err := NewPlan[int16](8, OrderSequency)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if err := p.Forward(make([]int16, 4)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := p.Inverse(make([]int16, 16)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+	t.Run("noalloc", func(tt *testing.T) {
+		p, err := NewPlan[int16](32, OrderSequency)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		x := make([]int16, 32)
+		allocs := testing.AllocsPerRun(100, func() {
+			p.Forward(x)
+			p.Inverse(x)
+		})
+		if allocs != 0 {
+			tt.Errorf("expect no allocation: %v", allocs)
+		}
+	})
+}
+
+func BenchmarkPlan(b *testing.B) {
+	for _, n := range []int{8, 32, 256} {
+		x := make([]int32, n)
+		for i := range x {
+			x[i] = int32(i)
+		}
+		b.Run("Transform/"+strconv.Itoa(n), func(tb *testing.B) {
+			tb.ReportAllocs()
+			for i := 0; i < tb.N; i += 1 {
+				Transform(x)
+				Invert(x)
+			}
+		})
+		b.Run("Plan/"+strconv.Itoa(n), func(tb *testing.B) {
+			p, err := NewPlan[int32](n, OrderSequency)
+			if err != nil {
+				tb.Fatalf("no error: %+v", err)
+			}
+			tb.ReportAllocs()
+			tb.ResetTimer()
+			for i := 0; i < tb.N; i += 1 {
+				p.Forward(x)
+				p.Inverse(x)
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// TransformReversible applies integer-to-integer Walsh-Hadamard Transform to a slice of any size 2^n.
+// It is built from lifting steps, so InvertReversible restores the input bit-exactly even if the input
+// is not a multiple of anything, which is what a lossless codec needs.
+// Each pair of butterfly stages is a 4-point Hadamard with 1/2 normalisation (the JPEG XR 2x2 Hadamard),
+// so the output is close to the orthonormal transform and grows by only about log2(n)/2 bits.
+// When log2(n) is odd, the remaining stage is an S-transform (floor of mean and difference).
+// The output is reordered to Sequency Order.
+func TransformReversible[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	bitsLen := bits.Len(uint(n)) - 1
+	quad := n
+	if bitsLen%2 == 1 {
+		quad = n / 2
+		liftS(in, quad)
+	}
+	for s := 1; s < quad; s <<= 2 {
+		for i := 0; i < n; i += s << 2 {
+			for j := i; j < i+s; j += 1 {
+				liftH(in, j, s)
+			}
+		}
+	}
+	Reorder(in, OrderNatural, OrderSequency)
+}
+
+// InvertReversible applies the inverse of TransformReversible.
+// Assumes the input is in Sequency Order.
+func InvertReversible[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+
+	Reorder(in, OrderSequency, OrderNatural)
+	bitsLen := bits.Len(uint(n)) - 1
+	quad := n
+	if bitsLen%2 == 1 {
+		quad = n / 2
+	}
+	top := 0
+	for s := 1; s < quad; s <<= 2 {
+		top = s
+	}
+	for s := top; 0 < s; s >>= 2 {
+		for i := 0; i < n; i += s << 2 {
+			for j := i; j < i+s; j += 1 {
+				unliftH(in, j, s)
+			}
+		}
+	}
+	if bitsLen%2 == 1 {
+		unliftS(in, quad)
+	}
+}
+
+// liftH is 4-point Hadamard of in[j], in[j+s], in[j+2s], in[j+3s] with 1/2 normalisation in Natural Order.
+func liftH[T SignedInt](in []T, j, s int) {
+	a, b, c, d := in[j], in[j+s], in[j+2*s], in[j+3*s]
+
+	a += d
+	b -= c
+	t := (a - b) >> 1
+	c, d = t-d, t-c
+	a -= d
+	b += c
+
+	in[j] = a     // (x0 + x1 + x2 + x3) / 2
+	in[j+s] = c   // (x0 - x1 + x2 - x3) / 2
+	in[j+2*s] = b // (x0 + x1 - x2 - x3) / 2
+	in[j+3*s] = d // (x0 - x1 - x2 + x3) / 2
+}
+
+func unliftH[T SignedInt](in []T, j, s int) {
+	a, c, b, d := in[j], in[j+s], in[j+2*s], in[j+3*s]
+
+	b -= c
+	a += d
+	t := (a - b) >> 1
+	c, d = t-d, t-c
+	b += c
+	a -= d
+
+	in[j] = a
+	in[j+s] = b
+	in[j+2*s] = c
+	in[j+3*s] = d
+}
+
+// liftS is S-transform of in[j] and in[j+half].
+func liftS[T SignedInt](in []T, half int) {
+	for j := 0; j < half; j += 1 {
+		h := in[j] - in[j+half]
+		in[j] = in[j+half] + (h >> 1)
+		in[j+half] = h
+	}
+}
+
+func unliftS[T SignedInt](in []T, half int) {
+	for j := 0; j < half; j += 1 {
+		h := in[j+half]
+		b := in[j] - (h >> 1)
+		in[j] = h + b
+		in[j+half] = b
+	}
+}
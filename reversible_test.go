@@ -0,0 +1,95 @@
+package wht
+
+import (
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformReversible(t *testing.T) {
+	t.Run("dc", func(tt *testing.T) {
+		x := make([]int16, 16)
+		for i := range x {
+			x[i] = 5
+		}
+		TransformReversible(x)
+		// orthonormal: 5 * 16 / sqrt(16)
+		expect1 := make([]int16, 16)
+		expect1[0] = 20
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		InvertReversible(x)
+		for i, v := range x {
+			if v != 5 {
+				tt.Errorf("[%d] %d != 5", i, v)
+			}
+		}
+	})
+	t.Run("lossless", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		for _, n := range []int{1, 2, 4, 8, 16, 32, 64, 256} {
+			for c := 0; c < 100; c += 1 {
+				x := make([]int32, n)
+				for i := range x {
+					x[i] = r.Int32N(1<<16) - (1 << 15)
+				}
+				orig := append([]int32(nil), x...)
+
+				TransformReversible(x)
+				InvertReversible(x)
+				if cmp.Equal(x, orig) != true {
+					tt.Fatalf("n=%d: %v != %v", n, x, orig)
+				}
+			}
+		}
+	})
+	t.Run("modified", func(tt *testing.T) {
+		// inverse of any coefficients is exactly reversible again
+		r := rand.New(rand.NewPCG(3, 4))
+		for _, n := range []int{8, 16} {
+			x := make([]int16, n)
+			for i := range x {
+				x[i] = int16(r.IntN(255) - 127)
+			}
+			y := append([]int16(nil), x...)
+			InvertReversible(y)
+			TransformReversible(y)
+			if cmp.Equal(x, y) != true {
+				tt.Errorf("n=%d: %v != %v", n, x, y)
+			}
+		}
+	})
+	t.Run("range", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(5, 6))
+		for _, n := range []int{16, 64, 256} {
+			x := make([]int16, n)
+			for i := range x {
+				if r.IntN(2) == 0 {
+					x[i] = 127
+				} else {
+					x[i] = -128
+				}
+			}
+			TransformReversible(x)
+
+			// plain Walsh-Hadamard grows up to 128 * n
+			// orthonormal grows up to 128 * sqrt(n)
+			limit := int16(128 * 2 * isqrt(n))
+			for i, v := range x {
+				if v < -limit || limit < v {
+					tt.Errorf("n=%d [%d] %d out of range %d", n, i, v, limit)
+				}
+			}
+		}
+	})
+}
+
+func isqrt(n int) int {
+	r := 0
+	for (r+1)*(r+1) <= n {
+		r += 1
+	}
+	return r
+}
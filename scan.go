@@ -0,0 +1,295 @@
+package wht
+
+import (
+	"sort"
+	"sync"
+)
+
+// ScanOrder identifies a scan order of a rows x cols block of coefficients.
+type ScanOrder int
+
+const (
+	// ScanZigzag is the classic JPEG zigzag, same as Zigzag.
+	ScanZigzag ScanOrder = iota
+	// ScanAlternate is the MPEG-2 alternate scan, which prefers vertical frequencies and suits field (interlaced) content.
+	// Blocks other than 8x8 follow the 8x8 table scaled to the block.
+	ScanAlternate
+	// ScanHorizontal is row by row (raster) order.
+	ScanHorizontal
+	// ScanVertical is column by column order.
+	ScanVertical
+	// ScanDiagonalUp walks each anti-diagonal from bottom-left to top-right.
+	ScanDiagonalUp
+	// ScanHilbert follows the Hilbert curve over the block.
+	ScanHilbert
+	// ScanSequencyEnergy sorts the coefficients by the product of row and column sequency,
+	// following the 1/(u*v) energy decay of 2D Walsh-Hadamard coefficients of natural images.
+	ScanSequencyEnergy
+
+	scanBuiltinMax
+)
+
+// ScanTableFunc builds the table of a scan order for a rows x cols block.
+// Entry i of the table is the row-major index (row*cols+col) of the i-th scanned coefficient.
+type ScanTableFunc func(rows, cols int) []int
+
+type scanKey struct {
+	order      ScanOrder
+	rows, cols int
+}
+
+var (
+	scanMutex  = new(sync.RWMutex)
+	scanFuncs  = map[ScanOrder]ScanTableFunc{}
+	scanTables = map[scanKey][]int{}
+	scanNext   = scanBuiltinMax
+)
+
+func init() {
+	scanFuncs[ScanZigzag] = zigzagTable
+	scanFuncs[ScanAlternate] = alternateTable
+	scanFuncs[ScanHorizontal] = horizontalTable
+	scanFuncs[ScanVertical] = verticalTable
+	scanFuncs[ScanDiagonalUp] = diagonalUpTable
+	scanFuncs[ScanHilbert] = hilbertTable
+	scanFuncs[ScanSequencyEnergy] = sequencyEnergyTable
+}
+
+// RegisterScanOrder registers a custom scan order built by fn and returns its identifier.
+func RegisterScanOrder(fn ScanTableFunc) ScanOrder {
+	scanMutex.Lock()
+	defer scanMutex.Unlock()
+
+	order := scanNext
+	scanNext += 1
+	scanFuncs[order] = fn
+	return order
+}
+
+// RegisterScanTable sets the table of order for a rows x cols block, replacing a cached table.
+// order may be an order from RegisterScanOrder or a new order used only with fixed tables;
+// built-in orders return ErrBuiltinScanOrder, so they always agree with Zigzag and friends.
+func RegisterScanTable(order ScanOrder, rows, cols int, table []int) error {
+	if order < scanBuiltinMax {
+		return ErrBuiltinScanOrder
+	}
+	if rows < 1 || cols < 1 {
+		return ErrEmpty
+	}
+	if isPermutation(table, rows*cols) != true {
+		return ErrInvalidScanTable
+	}
+
+	scanMutex.Lock()
+	defer scanMutex.Unlock()
+
+	scanTables[scanKey{order, rows, cols}] = append([]int(nil), table...)
+	if scanNext <= order {
+		scanNext = order + 1
+	}
+	return nil
+}
+
+// ScanTable returns the cached table of order for a rows x cols block.
+// The returned table is shared and must not be modified.
+func ScanTable(order ScanOrder, rows, cols int) ([]int, error) {
+	if rows < 1 || cols < 1 {
+		return nil, ErrEmpty
+	}
+
+	key := scanKey{order, rows, cols}
+	scanMutex.RLock()
+	table, ok := scanTables[key]
+	fn, known := scanFuncs[order]
+	scanMutex.RUnlock()
+	if ok {
+		return table, nil
+	}
+	if known != true {
+		return nil, ErrUnknownScanOrder
+	}
+
+	table = fn(rows, cols)
+	if isPermutation(table, rows*cols) != true {
+		return nil, ErrInvalidScanTable
+	}
+
+	scanMutex.Lock()
+	defer scanMutex.Unlock()
+
+	if cached, ok := scanTables[key]; ok {
+		return cached, nil
+	}
+	scanTables[key] = table
+	return table, nil
+}
+
+// Scan reads a rows x cols block of a flat buffer whose rows are stride elements apart
+// in the given order into out, which must have rows*cols elements.
+func Scan[T Signed](order ScanOrder, in []T, rows, cols, stride int, out []T) error {
+	if err := checkStrided(len(in), rows, cols, stride, len(out)); err != nil {
+		return err
+	}
+	table, err := ScanTable(order, rows, cols)
+	if err != nil {
+		return err
+	}
+
+	for i, idx := range table {
+		out[i] = in[(idx/cols)*stride+(idx%cols)]
+	}
+	return nil
+}
+
+// Unscan writes data read by Scan back to a rows x cols block of a flat buffer whose rows are stride elements apart.
+func Unscan[T Signed](order ScanOrder, data []T, rows, cols, stride int, out []T) error {
+	if err := checkStrided(len(out), rows, cols, stride, len(data)); err != nil {
+		return err
+	}
+	table, err := ScanTable(order, rows, cols)
+	if err != nil {
+		return err
+	}
+
+	for i, idx := range table {
+		out[(idx/cols)*stride+(idx%cols)] = data[i]
+	}
+	return nil
+}
+
+func isPermutation(table []int, n int) bool {
+	if len(table) != n {
+		return false
+	}
+	seen := make([]bool, n)
+	for _, v := range table {
+		if v < 0 || n <= v || seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+	return true
+}
+
+func zigzagTable(rows, cols int) []int {
+	table := make([]int, rows*cols)
+	row, col := 0, 0
+	goingUp := true
+	for i := range table {
+		table[i] = row*cols + col
+
+		cursor(rows, cols, &row, &col, &goingUp)
+	}
+	return table
+}
+
+// alternateScan8x8 is the MPEG-2 alternate scan of an 8x8 block (ISO/IEC 13818-2, alternate_scan = 1).
+var alternateScan8x8 = [64]int{
+	0, 8, 16, 24, 1, 9, 2, 10,
+	17, 25, 32, 40, 48, 56, 57, 49,
+	41, 33, 26, 18, 3, 11, 4, 12,
+	19, 27, 34, 42, 50, 58, 35, 43,
+	51, 59, 20, 28, 5, 13, 6, 14,
+	21, 29, 36, 44, 52, 60, 37, 45,
+	53, 61, 22, 30, 7, 15, 23, 31,
+	38, 46, 54, 62, 39, 47, 55, 63,
+}
+
+func alternateTable(rows, cols int) []int {
+	rank := [64]int{}
+	for i, idx := range alternateScan8x8 {
+		rank[idx] = i
+	}
+
+	// every coefficient takes the rank of its cell in the 8x8 table scaled to the block,
+	// coefficients sharing a cell are visited column by column
+	table := verticalTable(rows, cols)
+	sort.SliceStable(table, func(i, j int) bool {
+		ri, ci := table[i]/cols, table[i]%cols
+		rj, cj := table[j]/cols, table[j]%cols
+		return rank[(ri*8/rows)*8+ci*8/cols] < rank[(rj*8/rows)*8+cj*8/cols]
+	})
+	return table
+}
+
+func horizontalTable(rows, cols int) []int {
+	table := make([]int, rows*cols)
+	for i := range table {
+		table[i] = i
+	}
+	return table
+}
+
+func verticalTable(rows, cols int) []int {
+	table := make([]int, 0, rows*cols)
+	for col := 0; col < cols; col += 1 {
+		for row := 0; row < rows; row += 1 {
+			table = append(table, row*cols+col)
+		}
+	}
+	return table
+}
+
+func diagonalUpTable(rows, cols int) []int {
+	table := make([]int, 0, rows*cols)
+	for d := 0; d < rows+cols-1; d += 1 {
+		for row := min(d, rows-1); 0 <= row; row -= 1 {
+			col := d - row
+			if cols <= col {
+				break
+			}
+			table = append(table, row*cols+col)
+		}
+	}
+	return table
+}
+
+func hilbertTable(rows, cols int) []int {
+	// walk the Hilbert curve of the enclosing 2^n square and skip points outside the block
+	side := nextPowerOfTwo(max(rows, cols))
+	table := make([]int, 0, rows*cols)
+	for d := 0; d < side*side; d += 1 {
+		x, y := hilbertPoint(side, d)
+		if x < cols && y < rows {
+			table = append(table, y*cols+x)
+		}
+	}
+	return table
+}
+
+// hilbertPoint returns the coordinate of distance d on the Hilbert curve of a side x side square.
+func hilbertPoint(side, d int) (int, int) {
+	x, y := 0, 0
+	for s := 1; s < side; s <<= 1 {
+		rx := 1 & (d / 2)
+		ry := 1 & (d ^ rx)
+		if ry == 0 {
+			if rx == 1 {
+				x = s - 1 - x
+				y = s - 1 - y
+			}
+			x, y = y, x
+		}
+		x += s * rx
+		y += s * ry
+		d /= 4
+	}
+	return x, y
+}
+
+func sequencyEnergyTable(rows, cols int) []int {
+	table := horizontalTable(rows, cols)
+	sort.SliceStable(table, func(i, j int) bool {
+		ri, ci := table[i]/cols, table[i]%cols
+		rj, cj := table[j]/cols, table[j]%cols
+		pi, pj := (ri+1)*(ci+1), (rj+1)*(cj+1)
+		if pi != pj {
+			return pi < pj
+		}
+		if ri+ci != rj+cj {
+			return ri+ci < rj+cj
+		}
+		return ri < rj
+	})
+	return table
+}
@@ -0,0 +1,229 @@
+package wht
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScanTable(t *testing.T) {
+	t.Run("zigzag", func(tt *testing.T) {
+		for _, size := range [][2]int{{4, 4}, {8, 7}, {2, 5}, {1, 1}} {
+			rows, cols := size[0], size[1]
+			table, err := ScanTable(ScanZigzag, rows, cols)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			expect := make([]int32, rows*cols)
+			src := make([]int32, rows*cols)
+			for i := range src {
+				src[i] = int32(i)
+			}
+			if err := ZigzagStrided(src, rows, cols, cols, expect); err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			for i := range table {
+				if int32(table[i]) != expect[i] {
+					tt.Errorf("%dx%d: %v != %v", rows, cols, table, expect)
+					break
+				}
+			}
+		}
+	})
+	t.Run("alternate", func(tt *testing.T) {
+		table, err := ScanTable(ScanAlternate, 8, 8)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{
+			0, 8, 16, 24, 1, 9, 2, 10,
+			17, 25, 32, 40, 48, 56, 57, 49,
+			41, 33, 26, 18, 3, 11, 4, 12,
+			19, 27, 34, 42, 50, 58, 35, 43,
+			51, 59, 20, 28, 5, 13, 6, 14,
+			21, 29, 36, 44, 52, 60, 37, 45,
+			53, 61, 22, 30, 7, 15, 23, 31,
+			38, 46, 54, 62, 39, 47, 55, 63,
+		}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+
+		// 4x4 takes every other cell of the 8x8 table
+		table, err = ScanTable(ScanAlternate, 4, 4)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect = []int{0, 4, 1, 8, 12, 5, 2, 9, 13, 6, 3, 10, 14, 7, 11, 15}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+
+		// 16x16 visits the 2x2 coefficients of each cell together, column by column
+		table, err = ScanTable(ScanAlternate, 16, 16)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect = []int{0, 16, 1, 17, 32, 48, 33, 49}
+		if cmp.Equal(table[:8], expect) != true {
+			tt.Errorf("%v != %v", table[:8], expect)
+		}
+	})
+	t.Run("horizontal", func(tt *testing.T) {
+		table, err := ScanTable(ScanHorizontal, 2, 3)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{0, 1, 2, 3, 4, 5}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+	})
+	t.Run("vertical", func(tt *testing.T) {
+		table, err := ScanTable(ScanVertical, 2, 3)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{0, 3, 1, 4, 2, 5}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+	})
+	t.Run("diagonal up", func(tt *testing.T) {
+		table, err := ScanTable(ScanDiagonalUp, 3, 3)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{0, 3, 1, 6, 4, 2, 7, 5, 8}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+	})
+	t.Run("hilbert", func(tt *testing.T) {
+		table, err := ScanTable(ScanHilbert, 2, 2)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{0, 2, 3, 1}
+		if cmp.Equal(table, expect) != true {
+			tt.Errorf("%v != %v", table, expect)
+		}
+
+		table, err = ScanTable(ScanHilbert, 8, 8)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i := 1; i < len(table); i += 1 {
+			dr := table[i]/8 - table[i-1]/8
+			dc := table[i]%8 - table[i-1]%8
+			if dr*dr+dc*dc != 1 {
+				tt.Errorf("[%d] %d -> %d is not adjacent", i, table[i-1], table[i])
+			}
+		}
+	})
+	t.Run("sequency energy", func(tt *testing.T) {
+		table, err := ScanTable(ScanSequencyEnergy, 4, 4)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int{0, 1, 4, 2, 8, 5, 3, 12}
+		if cmp.Equal(table[:8], expect) != true {
+			tt.Errorf("%v != %v", table[:8], expect)
+		}
+	})
+	t.Run("cached", func(tt *testing.T) {
+		a, _ := ScanTable(ScanHilbert, 16, 16)
+		b, _ := ScanTable(ScanHilbert, 16, 16)
+		if &a[0] != &b[0] {
+			tt.Errorf("expect cached table")
+		}
+	})
+	t.Run("unknown", func(tt *testing.T) {
+		if _, err := ScanTable(ScanOrder(1<<20), 4, 4); errors.Is(err, ErrUnknownScanOrder) != true {
+			tt.Errorf("expect ErrUnknownScanOrder: %+v", err)
+		}
+		if _, err := ScanTable(ScanZigzag, 0, 4); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
+
+func TestScan(t *testing.T) {
+	orders := []ScanOrder{
+		ScanZigzag, ScanAlternate, ScanHorizontal, ScanVertical,
+		ScanDiagonalUp, ScanHilbert, ScanSequencyEnergy,
+	}
+	rows, cols, stride := 8, 7, 9
+	in := make([]int16, (rows-1)*stride+cols)
+	for i := range in {
+		in[i] = int16(i)
+	}
+	for _, order := range orders {
+		out := make([]int16, rows*cols)
+		if err := Scan(order, in, rows, cols, stride, out); err != nil {
+			t.Fatalf("order=%d no error: %+v", order, err)
+		}
+		restored := make([]int16, len(in))
+		for i := range restored {
+			if i%stride < cols {
+				continue
+			}
+			restored[i] = int16(i)
+		}
+		if err := Unscan(order, out, rows, cols, stride, restored); err != nil {
+			t.Fatalf("order=%d no error: %+v", order, err)
+		}
+		if cmp.Equal(restored, in) != true {
+			t.Errorf("order=%d: %v != %v", order, restored, in)
+		}
+	}
+}
+
+func TestRegisterScan(t *testing.T) {
+	t.Run("order", func(tt *testing.T) {
+		reverse := RegisterScanOrder(func(rows, cols int) []int {
+			table := make([]int, rows*cols)
+			for i := range table {
+				table[i] = len(table) - 1 - i
+			}
+			return table
+		})
+		out := make([]int16, 4)
+		if err := Scan(reverse, []int16{1, 2, 3, 4}, 2, 2, 2, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{4, 3, 2, 1}
+		if cmp.Equal(out, expect) != true {
+			tt.Errorf("%v != %v", out, expect)
+		}
+	})
+	t.Run("table", func(tt *testing.T) {
+		custom := RegisterScanOrder(func(rows, cols int) []int {
+			return nil
+		})
+		if _, err := ScanTable(custom, 2, 2); errors.Is(err, ErrInvalidScanTable) != true {
+			tt.Errorf("expect ErrInvalidScanTable: %+v", err)
+		}
+		if err := RegisterScanTable(custom, 2, 2, []int{3, 1, 2, 0}); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		out := make([]int16, 4)
+		if err := Scan(custom, []int16{1, 2, 3, 4}, 2, 2, 2, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{4, 2, 3, 1}
+		if cmp.Equal(out, expect) != true {
+			tt.Errorf("%v != %v", out, expect)
+		}
+		if err := RegisterScanTable(custom, 2, 2, []int{0, 0, 1, 2}); errors.Is(err, ErrInvalidScanTable) != true {
+			tt.Errorf("expect ErrInvalidScanTable: %+v", err)
+		}
+		if err := RegisterScanTable(ScanZigzag, 2, 2, []int{3, 1, 2, 0}); errors.Is(err, ErrBuiltinScanOrder) != true {
+			tt.Errorf("expect ErrBuiltinScanOrder: %+v", err)
+		}
+		if err := RegisterScanTable(ScanSequencyEnergy, 2, 2, []int{3, 1, 2, 0}); errors.Is(err, ErrBuiltinScanOrder) != true {
+			tt.Errorf("expect ErrBuiltinScanOrder: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// SlidingTransform maintains the Walsh-Hadamard Transform of the last n = 2^m samples of a stream.
+// The transform of size 2s of the window ending at t is [U + V, U - V] in Natural Order,
+// where U and V are the transforms of size s of the windows ending at t-s and t.
+// Keeping the last s transforms of every size s makes each Push O(n) instead of O(n log n),
+// at the cost of about n^2/3 elements of history.
+// The window starts filled with zeros. A SlidingTransform is not safe for concurrent use.
+type SlidingTransform[T Signed] struct {
+	n       int
+	order   Order
+	history [][]T // history[l] is the ring of the last 2^l transforms of size 2^l
+	pos     []int
+	cur     []T
+	next    []T
+	perm    []int // coefficient k in order is perm[k] in Natural Order
+	out     []T
+}
+
+// NewSlidingTransform creates a SlidingTransform over a window of n samples with the coefficients in the given order.
+func NewSlidingTransform[T Signed](n int, order Order) (*SlidingTransform[T], error) {
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+
+	bitsLen := bits.Len(uint(n)) - 1
+	s := &SlidingTransform[T]{
+		n:       n,
+		order:   order,
+		history: make([][]T, bitsLen),
+		pos:     make([]int, bitsLen),
+		cur:     make([]T, n),
+		next:    make([]T, n),
+		perm:    make([]int, n),
+		out:     make([]T, n),
+	}
+	for l := range s.history {
+		s.history[l] = make([]T, 1<<(2*l))
+	}
+	for k := 0; k < n; k += 1 {
+		s.perm[k] = NaturalIndex(order, k, bitsLen)
+	}
+	return s, nil
+}
+
+// Len returns the window length.
+func (s *SlidingTransform[T]) Len() int {
+	return s.n
+}
+
+// Order returns the order of coefficients Push produces.
+func (s *SlidingTransform[T]) Order() Order {
+	return s.order
+}
+
+// Push appends sample to the window, drops the oldest sample and returns the transform of the window.
+// The returned slice is shared and overwritten by the next Push or Reset.
+func (s *SlidingTransform[T]) Push(sample T) []T {
+	cur, next := s.cur, s.next
+	cur[0] = sample
+	for l, size := 0, 1; size < s.n; l, size = l+1, size<<1 {
+		// old is the transform of size `size` of the window ending `size` samples ago
+		slot := s.history[l][s.pos[l]*size : (s.pos[l]+1)*size]
+		for i := 0; i < size; i += 1 {
+			next[i] = slot[i] + cur[i]
+			next[size+i] = slot[i] - cur[i]
+		}
+		copy(slot, cur[:size])
+		s.pos[l] = (s.pos[l] + 1) % size
+		cur, next = next, cur
+	}
+
+	for k, nat := range s.perm {
+		s.out[k] = cur[nat]
+	}
+	return s.out
+}
+
+// Reset refills the window with zeros.
+func (s *SlidingTransform[T]) Reset() {
+	for l := range s.history {
+		clear(s.history[l])
+		s.pos[l] = 0
+	}
+	clear(s.out)
+}
@@ -0,0 +1,115 @@
+package wht
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestSlidingTransform(t *testing.T) {
+	for _, order := range []Order{OrderSequency, OrderNatural, OrderDyadic} {
+		t.Run(order.String(), func(tt *testing.T) {
+			r := rand.New(rand.NewPCG(1, 2))
+			for _, n := range []int{1, 2, 4, 8, 16, 64} {
+				s, err := NewSlidingTransform[int32](n, order)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				window := make([]int32, n)
+				for step := 0; step < 3*n+5; step += 1 {
+					sample := int32(r.IntN(512) - 256)
+					copy(window, window[1:])
+					window[n-1] = sample
+
+					got := s.Push(sample)
+					expect := append([]int32(nil), window...)
+					TransformOrder(expect, order)
+					if cmp.Equal(got, expect) != true {
+						tt.Fatalf("n=%d step=%d: %v != %v", n, step, got, expect)
+					}
+				}
+			}
+		})
+	}
+	t.Run("float", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(3, 4))
+		s, err := NewSlidingTransform[float64](32, OrderSequency)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		window := make([]float64, 32)
+		for step := 0; step < 200; step += 1 {
+			sample := r.NormFloat64()
+			copy(window, window[1:])
+			window[31] = sample
+
+			got := s.Push(sample)
+			expect := append([]float64(nil), window...)
+			Transform(expect)
+			if cmp.Equal(got, expect, cmpopts.EquateApprox(0, 1e-9)) != true {
+				tt.Fatalf("step=%d: %v != %v", step, got, expect)
+			}
+		}
+	})
+	t.Run("reset", func(tt *testing.T) {
+		s, _ := NewSlidingTransform[int16](8, OrderSequency)
+		for i := 0; i < 10; i += 1 {
+			s.Push(int16(i))
+		}
+		s.Reset()
+		got := s.Push(3)
+		expect := []int16{0, 0, 0, 0, 0, 0, 0, 3}
+		Transform(expect)
+		if cmp.Equal(got, expect) != true {
+			tt.Errorf("%v != %v", got, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := NewSlidingTransform[int32](12, OrderSequency); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if _, err := NewSlidingTransform[int32](0, OrderSequency); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+	t.Run("noalloc", func(tt *testing.T) {
+		s, _ := NewSlidingTransform[int32](64, OrderSequency)
+		allocs := testing.AllocsPerRun(100, func() {
+			s.Push(1)
+		})
+		if allocs != 0 {
+			tt.Errorf("expect no allocation: %v", allocs)
+		}
+	})
+}
+
+func BenchmarkSlidingTransform(b *testing.B) {
+	for _, n := range []int{16, 256} {
+		b.Run("Transform/"+strconv.Itoa(n), func(tb *testing.B) {
+			window := make([]int32, n)
+			x := make([]int32, n)
+			tb.ReportAllocs()
+			for i := 0; i < tb.N; i += 1 {
+				copy(window, window[1:])
+				window[n-1] = int32(i)
+				copy(x, window)
+				Transform(x)
+			}
+		})
+		b.Run("Sliding/"+strconv.Itoa(n), func(tb *testing.B) {
+			s, err := NewSlidingTransform[int32](n, OrderSequency)
+			if err != nil {
+				tb.Fatalf("no error: %+v", err)
+			}
+			tb.ReportAllocs()
+			tb.ResetTimer()
+			for i := 0; i < tb.N; i += 1 {
+				s.Push(int32(i))
+			}
+		})
+	}
+}
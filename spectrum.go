@@ -0,0 +1,178 @@
+package wht
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+// PowerSpectrum returns the power of every sequency, X[k]^2 / n in Sequency Order.
+// By Parseval's theorem the powers sum to the energy of the input, the sum of in[i]^2.
+// The input is not modified.
+func PowerSpectrum[T Signed](in []T) ([]float64, error) {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+
+	coeffs := make([]float64, n)
+	for i, v := range in {
+		coeffs[i] = float64(v)
+	}
+	Transform(coeffs)
+	for k, v := range coeffs {
+		coeffs[k] = v * v / float64(n)
+	}
+	return coeffs, nil
+}
+
+// LowPass keeps the sequencies below cutoff of in, in place.
+// Integers are filtered in int64 and rounded to the nearest; ErrOverflow is returned, leaving in unchanged,
+// when a filtered sample does not fit in T.
+func LowPass[T Signed](in []T, cutoff int) error {
+	return BandPass(in, 0, cutoff)
+}
+
+// HighPass keeps the sequencies from cutoff of in, in place.
+func HighPass[T Signed](in []T, cutoff int) error {
+	return BandPass(in, cutoff, len(in))
+}
+
+// BandPass keeps the sequencies low <= k < high of in, in place.
+func BandPass[T Signed](in []T, low, high int) error {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if low < 0 || high < low || n < high {
+		return ErrOutOfRange
+	}
+
+	if isInteger[T]() {
+		return bandPassInt(in, low, high)
+	}
+	Transform(in)
+	clear(in[:low])
+	clear(in[high:])
+	Invert(in)
+	return nil
+}
+
+// bandPassInt filters in int64, since the transform in T grows by log2(n) bits and overflows narrow types.
+func bandPassInt[T Signed](in []T, low, high int) error {
+	n := len(in)
+	wide := make([]int64, n)
+	for i, v := range in {
+		wide[i] = int64(v)
+	}
+	if err := fwhtChecked(wide, n); err != nil {
+		return err
+	}
+	bitsLen := bits.Len(uint(n)) - 1
+	for nat := range wide {
+		if k := OrderIndex(OrderSequency, nat, bitsLen); k < low || high <= k {
+			wide[nat] = 0
+		}
+	}
+	// H * H = nI in Natural Order
+	if err := fwhtChecked(wide, n); err != nil {
+		return err
+	}
+
+	half := int64(n / 2)
+	for i, v := range wide {
+		// round half away from zero
+		switch {
+		case 0 <= v && v <= math.MaxInt64-half:
+			v = (v + half) / int64(n)
+		case v < 0 && math.MinInt64+half <= v:
+			v = (v - half) / int64(n)
+		default:
+			return ErrOverflow
+		}
+		if int64(T(v)) != v {
+			return ErrOverflow
+		}
+		wide[i] = v
+	}
+	for i, v := range wide {
+		in[i] = T(v)
+	}
+	return nil
+}
+
+// HardThreshold sets the coefficients with |c| <= threshold to zero and keeps the others.
+func HardThreshold[T Signed](coeffs []T, threshold T) {
+	for i, c := range coeffs {
+		// compare signed, |c| of the minimum integer is not representable
+		if (c < -threshold || threshold < c) != true {
+			coeffs[i] = 0
+		}
+	}
+}
+
+// SoftThreshold shrinks the coefficients toward zero by threshold, sign(c) * max(|c| - threshold, 0).
+func SoftThreshold[T Signed](coeffs []T, threshold T) {
+	for i, c := range coeffs {
+		switch {
+		case threshold < c:
+			coeffs[i] = c - threshold
+		case c < -threshold:
+			coeffs[i] = c + threshold
+		default:
+			coeffs[i] = 0
+		}
+	}
+}
+
+// CumulativeEnergy returns the fraction of the energy in coeffs[0..k] for every k.
+// For coefficients in Sequency Order it shows how much a LowPass of cutoff k+1 retains.
+// All coefficients zero is reported as fully captured.
+func CumulativeEnergy[T Signed](coeffs []T) []float64 {
+	cumulative := make([]float64, len(coeffs))
+	sum := 0.0
+	for k, c := range coeffs {
+		sum += float64(c) * float64(c)
+		cumulative[k] = sum
+	}
+	for k := range cumulative {
+		if sum == 0 {
+			cumulative[k] = 1
+		} else {
+			cumulative[k] /= sum
+		}
+	}
+	return cumulative
+}
+
+// CoefficientsForEnergy returns the smallest number of coefficients, taken in decreasing magnitude,
+// that hold at least fraction (0 to 1) of the energy of coeffs.
+func CoefficientsForEnergy[T Signed](coeffs []T, fraction float64) (int, error) {
+	if (0 <= fraction && fraction <= 1) != true {
+		return 0, ErrOutOfRange
+	}
+
+	energy := make([]float64, len(coeffs))
+	total := 0.0
+	for i, c := range coeffs {
+		energy[i] = float64(c) * float64(c)
+		total += energy[i]
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(energy)))
+
+	target := fraction * total
+	sum := 0.0
+	for i, e := range energy {
+		if target <= sum {
+			return i, nil
+		}
+		sum += e
+	}
+	return len(energy), nil
+}
+
+// isInteger reports whether T is an integer type, where 1/2 truncates to zero.
+func isInteger[T Signed]() bool {
+	one := T(1)
+	return one/2 == 0
+}
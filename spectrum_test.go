@@ -0,0 +1,326 @@
+package wht
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPowerSpectrum(t *testing.T) {
+	t.Run("parseval", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		x := make([]float64, 64)
+		energy := 0.0
+		for i := range x {
+			x[i] = r.NormFloat64()
+			energy += x[i] * x[i]
+		}
+		orig := append([]float64(nil), x...)
+		p, err := PowerSpectrum(x)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		sum := 0.0
+		for _, v := range p {
+			sum += v
+		}
+		if math.Abs(sum-energy) > 1e-9 {
+			tt.Errorf("%v != %v", sum, energy)
+		}
+		if cmp.Equal(x, orig) != true {
+			tt.Errorf("input modified")
+		}
+	})
+	t.Run("walsh", func(tt *testing.T) {
+		// Walsh function 5 has all of its power at sequency 5
+		w, _ := WalshFunction[int16](5, 16, OrderSequency)
+		p, err := PowerSpectrum(w)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for k, v := range p {
+			expect := 0.0
+			if k == 5 {
+				expect = 16
+			}
+			if v != expect {
+				tt.Errorf("p[%d]=%v != %v", k, v, expect)
+			}
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := PowerSpectrum(make([]int32, 6)); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	walsh := func(k int) []float64 {
+		w, _ := WalshFunction[float64](k, 32, OrderSequency)
+		return w
+	}
+	mix := func() []float64 {
+		// sequency 1, 6 and 20
+		x := make([]float64, 32)
+		for _, k := range []int{1, 6, 20} {
+			for i, v := range walsh(k) {
+				x[i] += float64(k) * v
+			}
+		}
+		return x
+	}
+	scaled := func(k int) []float64 {
+		w := walsh(k)
+		for i := range w {
+			w[i] *= float64(k)
+		}
+		return w
+	}
+	approx := cmpopts.EquateApprox(0, 1e-12)
+
+	t.Run("lowpass", func(tt *testing.T) {
+		x := mix()
+		if err := LowPass(x, 4); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := scaled(1); cmp.Equal(x, expect, approx) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("highpass", func(tt *testing.T) {
+		x := mix()
+		if err := HighPass(x, 10); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := scaled(20); cmp.Equal(x, expect, approx) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("bandpass", func(tt *testing.T) {
+		x := mix()
+		if err := BandPass(x, 6, 7); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := scaled(6); cmp.Equal(x, expect, approx) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+
+		y := mix()
+		if err := BandPass(y, 0, 32); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := mix(); cmp.Equal(y, expect, approx) != true {
+			tt.Errorf("all pass: %v != %v", y, expect)
+		}
+	})
+	t.Run("int", func(tt *testing.T) {
+		// step of DC 10 and sequency 1 amplitude 4, the low pass removes the square wave of sequency 8
+		x := make([]int32, 16)
+		w1, _ := WalshFunction[int32](1, 16, OrderSequency)
+		w8, _ := WalshFunction[int32](8, 16, OrderSequency)
+		expect := make([]int32, 16)
+		for i := range x {
+			expect[i] = 10 + 4*w1[i]
+			x[i] = expect[i] + 3*w8[i]
+		}
+		if err := LowPass(x, 8); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("narrow", func(tt *testing.T) {
+		// the transform of these overflows int8 and int16, filtering must not
+		x := make([]int16, 16)
+		for i := range x {
+			x[i] = 3000
+		}
+		if err := LowPass(x, 16); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i, v := range x {
+			if v != 3000 {
+				tt.Errorf("[%d] %d != 3000", i, v)
+			}
+		}
+
+		b := []int8{100, 100, 100, 100, 100, 100, 100, 100}
+		if err := BandPass(b, 0, 8); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := []int8{100, 100, 100, 100, 100, 100, 100, 100}; cmp.Equal(b, expect) != true {
+			tt.Errorf("%v != %v", b, expect)
+		}
+
+		// DC of {127, 127, -128, -128, 127, 127, -128, -128} is -0.5, the sequency 2 part is +/-127.5
+		c := []int8{127, 127, -128, -128, 127, 127, -128, -128}
+		if err := LowPass(c, 1); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := []int8{-1, -1, -1, -1, -1, -1, -1, -1}; cmp.Equal(c, expect) != true {
+			tt.Errorf("%v != %v", c, expect)
+		}
+
+		d := []int16{32767, -32768, 32767, -32768}
+		if err := LowPass(d, 4); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if expect := []int16{32767, -32768, 32767, -32768}; cmp.Equal(d, expect) != true {
+			tt.Errorf("%v != %v", d, expect)
+		}
+	})
+	t.Run("overflow", func(tt *testing.T) {
+		// removing DC -0.5 makes 127 into 127.5, which rounds to 128
+		x := []int8{127, 127, -128, -128, 127, 127, -128, -128}
+		if err := HighPass(x, 1); errors.Is(err, ErrOverflow) != true {
+			tt.Errorf("expect ErrOverflow: %+v", err)
+		}
+		if expect := []int8{127, 127, -128, -128, 127, 127, -128, -128}; cmp.Equal(x, expect) != true {
+			tt.Errorf("input modified: %v != %v", x, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if err := LowPass(make([]float64, 8), 9); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if err := BandPass(make([]float64, 8), 5, 3); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if err := HighPass(make([]int16, 0), 0); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
+
+func TestThreshold(t *testing.T) {
+	t.Run("hard", func(tt *testing.T) {
+		x := []float64{5, -0.5, 2, -3, 1, 0}
+		HardThreshold(x, 1)
+		if expect := []float64{5, 0, 2, -3, 0, 0}; cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+		y := []int16{7, -2, 3, -9}
+		HardThreshold(y, 3)
+		if expect := []int16{7, 0, 0, -9}; cmp.Equal(y, expect) != true {
+			tt.Errorf("%v != %v", y, expect)
+		}
+		z := []int8{-128, 5, 100, -3, 127}
+		HardThreshold(z, 10)
+		if expect := []int8{-128, 0, 100, 0, 127}; cmp.Equal(z, expect) != true {
+			tt.Errorf("%v != %v", z, expect)
+		}
+	})
+	t.Run("soft", func(tt *testing.T) {
+		x := []float64{5, -0.5, 2, -3, 1, 0}
+		SoftThreshold(x, 1)
+		if expect := []float64{4, 0, 1, -2, 0, 0}; cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+		y := []int32{7, -2, 3, -9}
+		SoftThreshold(y, 3)
+		if expect := []int32{4, 0, 0, -6}; cmp.Equal(y, expect) != true {
+			tt.Errorf("%v != %v", y, expect)
+		}
+		z := []int16{-32768, 50, 32767, -5}
+		SoftThreshold(z, 10)
+		if expect := []int16{-32758, 40, 32757, 0}; cmp.Equal(z, expect) != true {
+			tt.Errorf("%v != %v", z, expect)
+		}
+	})
+	t.Run("denoise", func(tt *testing.T) {
+		// a sparse sequency signal under noise: thresholding the coefficients reduces the error
+		r := rand.New(rand.NewPCG(3, 4))
+		n := 256
+		clean := make([]float64, n)
+		for _, k := range []int{0, 3, 17, 100} {
+			w, _ := WalshFunction[float64](k, n, OrderSequency)
+			for i, v := range w {
+				clean[i] += 2 * v
+			}
+		}
+		noisy := make([]float64, n)
+		for i := range noisy {
+			noisy[i] = clean[i] + 0.5*r.NormFloat64()
+		}
+		mse := func(x []float64) float64 {
+			sum := 0.0
+			for i := range x {
+				sum += (x[i] - clean[i]) * (x[i] - clean[i])
+			}
+			return sum / float64(n)
+		}
+
+		for _, fn := range []func([]float64, float64){HardThreshold[float64], SoftThreshold[float64]} {
+			x := append([]float64(nil), noisy...)
+			Transform(x)
+			// 3 sigma of the coefficient noise, sigma*sqrt(n)
+			fn(x, 3*0.5*math.Sqrt(float64(n)))
+			Invert(x)
+			if before, after := mse(noisy), mse(x); before/4 < after {
+				tt.Errorf("mse %v -> %v", before, after)
+			}
+		}
+	})
+}
+
+func TestEnergy(t *testing.T) {
+	t.Run("cumulative", func(tt *testing.T) {
+		c := CumulativeEnergy([]int16{3, 0, 4, 0})
+		if expect := []float64{0.36, 0.36, 1, 1}; cmp.Equal(c, expect, cmpopts.EquateApprox(0, 1e-12)) != true {
+			tt.Errorf("%v != %v", c, expect)
+		}
+		if c := CumulativeEnergy([]float64{0, 0}); cmp.Equal(c, []float64{1, 1}) != true {
+			tt.Errorf("zero: %v", c)
+		}
+	})
+	t.Run("count", func(tt *testing.T) {
+		coeffs := []float64{1, -4, 0, 2, 0, 0, 0, 0}
+		for _, tc := range []struct {
+			fraction float64
+			expect   int
+		}{
+			{0, 0},
+			{0.5, 1},
+			{16.0 / 21, 1},
+			{0.8, 2},
+			{20.0 / 21, 2},
+			{0.99, 3},
+			{1, 3},
+		} {
+			k, err := CoefficientsForEnergy(coeffs, tc.fraction)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			if k != tc.expect {
+				tt.Errorf("fraction=%v: %d != %d", tc.fraction, k, tc.expect)
+			}
+		}
+		if _, err := CoefficientsForEnergy(coeffs, 1.5); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := CoefficientsForEnergy(coeffs, math.NaN()); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+	})
+	t.Run("compaction", func(tt *testing.T) {
+		// a smooth ramp compacts into few low sequencies
+		x := make([]float64, 64)
+		for i := range x {
+			x[i] = float64(i)
+		}
+		Transform(x)
+		k, _ := CoefficientsForEnergy(x, 0.99)
+		if k > 8 {
+			tt.Errorf("%d coefficients for 99%%", k)
+		}
+		if c := CumulativeEnergy(x); c[1] < 0.9 {
+			tt.Errorf("first two sequencies hold %v", c[1])
+		}
+	})
+}
@@ -0,0 +1,117 @@
+// Package srht implements the Subsampled Randomized Hadamard Transform, a fast Johnson-Lindenstrauss projection.
+package srht
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sort"
+
+	"github.com/octu0/wht"
+)
+
+var (
+	ErrInvalidDim     = errors.New("srht: invalid dimension")
+	ErrLengthMismatch = errors.New("srht: length mismatch")
+)
+
+// SRHT projects vectors of dimension d to dimension k by y = sqrt(n/k) * P * H * D * x,
+// where x is zero padded to n = 2^m >= d, D flips signs at random, H is the orthonormal Walsh-Hadamard Transform
+// and P samples k of the n coefficients without replacement.
+// It preserves squared norms in expectation and costs O(n log n) per vector.
+// An SRHT is not safe for concurrent use; create one per goroutine with the same seed.
+type SRHT[T wht.Float] struct {
+	d, k, n int
+	signs   []T
+	rows    []int
+	temp    []T
+}
+
+// New creates an SRHT from dimension d to dimension k, 1 <= k <= 2^ceil(log2 d).
+// The same seed always produces the same projection.
+func New[T wht.Float](d, k int, seed uint64) (*SRHT[T], error) {
+	if d < 1 {
+		return nil, ErrInvalidDim
+	}
+	n := 1
+	for n < d {
+		n <<= 1
+	}
+	if k < 1 || n < k {
+		return nil, ErrInvalidDim
+	}
+
+	r := rand.New(rand.NewPCG(seed, seed))
+	signs := make([]T, d)
+	for i := range signs {
+		if r.Uint64()&1 == 0 {
+			signs[i] = 1
+		} else {
+			signs[i] = -1
+		}
+	}
+	rows := r.Perm(n)[:k]
+	sort.Ints(rows)
+
+	return &SRHT[T]{
+		d:     d,
+		k:     k,
+		n:     n,
+		signs: signs,
+		rows:  rows,
+		temp:  make([]T, n),
+	}, nil
+}
+
+// InDim returns the input dimension d.
+func (s *SRHT[T]) InDim() int {
+	return s.d
+}
+
+// OutDim returns the output dimension k.
+func (s *SRHT[T]) OutDim() int {
+	return s.k
+}
+
+// Project returns the projection of in, which must have d elements.
+func (s *SRHT[T]) Project(in []T) ([]T, error) {
+	out := make([]T, s.k)
+	if err := s.ProjectTo(in, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProjectTo writes the projection of in to out, which must have d and k elements. It does not allocate.
+func (s *SRHT[T]) ProjectTo(in, out []T) error {
+	if len(in) != s.d || len(out) != s.k {
+		return ErrLengthMismatch
+	}
+
+	for i, v := range in {
+		s.temp[i] = s.signs[i] * v
+	}
+	clear(s.temp[s.d:])
+	// sampled rows are random, so the coefficient order does not matter
+	wht.TransformOrder(s.temp, wht.OrderNatural)
+
+	// 1/sqrt(n) of orthonormal H and sqrt(n/k) of sampling
+	norm := T(1 / math.Sqrt(float64(s.k)))
+	for i, row := range s.rows {
+		out[i] = s.temp[row] * norm
+	}
+	return nil
+}
+
+// ProjectBatch returns the projection of every vector in batch.
+func (s *SRHT[T]) ProjectBatch(batch [][]T) ([][]T, error) {
+	out := make([][]T, len(batch))
+	for i, in := range batch {
+		y, err := s.Project(in)
+		if err != nil {
+			return nil, err
+		}
+		out[i] = y
+	}
+	return out, nil
+}
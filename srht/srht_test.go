@@ -0,0 +1,175 @@
+package srht
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func squaredNorm[T float32 | float64](x []T) float64 {
+	sum := 0.0
+	for _, v := range x {
+		sum += float64(v) * float64(v)
+	}
+	return sum
+}
+
+func randomVector(r *rand.Rand, d int) []float64 {
+	x := make([]float64, d)
+	for i := range x {
+		x[i] = r.NormFloat64()
+	}
+	return x
+}
+
+func TestSRHT(t *testing.T) {
+	t.Run("norm", func(tt *testing.T) {
+		// E[|y|^2] = |x|^2 and the ratio concentrates around 1 (Johnson-Lindenstrauss)
+		const d, k, trials = 300, 128, 500
+		r := rand.New(rand.NewPCG(1, 2))
+		sum, within := 0.0, 0
+		for i := 0; i < trials; i += 1 {
+			s, err := New[float64](d, k, uint64(i))
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			x := randomVector(r, d)
+			y, err := s.Project(x)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			ratio := squaredNorm(y) / squaredNorm(x)
+			sum += ratio
+			if 0.7 < ratio && ratio < 1.3 {
+				within += 1
+			}
+		}
+		if mean := sum / trials; math.Abs(mean-1) > 0.03 {
+			tt.Errorf("mean ratio %v expect 1", mean)
+		}
+		if within < trials*95/100 {
+			tt.Errorf("%d/%d ratios within 1+/-0.3", within, trials)
+		}
+	})
+	t.Run("distance", func(tt *testing.T) {
+		const d, k = 1000, 256
+		s, err := New[float64](d, k, 42)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		r := rand.New(rand.NewPCG(3, 4))
+		points := make([][]float64, 20)
+		for i := range points {
+			points[i] = randomVector(r, d)
+		}
+		projected, err := s.ProjectBatch(points)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i := range points {
+			for j := i + 1; j < len(points); j += 1 {
+				dx, dy := make([]float64, d), make([]float64, k)
+				for m := range dx {
+					dx[m] = points[i][m] - points[j][m]
+				}
+				for m := range dy {
+					dy[m] = projected[i][m] - projected[j][m]
+				}
+				if ratio := squaredNorm(dy) / squaredNorm(dx); ratio < 0.6 || 1.4 < ratio {
+					tt.Errorf("(%d,%d): distance ratio %v", i, j, ratio)
+				}
+			}
+		}
+	})
+	t.Run("full", func(tt *testing.T) {
+		// k = n keeps every coefficient, so the projection is an isometry
+		s, err := New[float64](12, 16, 7)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		x := randomVector(rand.New(rand.NewPCG(5, 6)), 12)
+		y, err := s.Project(x)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if a, b := squaredNorm(y), squaredNorm(x); math.Abs(a-b) > 1e-9 {
+			tt.Errorf("%v != %v", a, b)
+		}
+	})
+	t.Run("seed", func(tt *testing.T) {
+		x := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+		a, _ := New[float32](10, 4, 99)
+		b, _ := New[float32](10, 4, 99)
+		c, _ := New[float32](10, 4, 100)
+		ya, _ := a.Project(x)
+		yb, _ := b.Project(x)
+		yc, _ := c.Project(x)
+		if cmp.Equal(ya, yb) != true {
+			tt.Errorf("same seed: %v != %v", ya, yb)
+		}
+		if cmp.Equal(ya, yc) {
+			tt.Errorf("different seed: %v == %v", ya, yc)
+		}
+	})
+	t.Run("float32", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(7, 8))
+		x64 := randomVector(r, 50)
+		x32 := make([]float32, len(x64))
+		for i, v := range x64 {
+			x32[i] = float32(v)
+		}
+		s64, _ := New[float64](50, 20, 1)
+		s32, _ := New[float32](50, 20, 1)
+		y64, _ := s64.Project(x64)
+		y32, _ := s32.Project(x32)
+		for i := range y64 {
+			if math.Abs(y64[i]-float64(y32[i])) > 1e-4 {
+				tt.Errorf("[%d] %v != %v", i, y64[i], y32[i])
+			}
+		}
+	})
+	t.Run("batch", func(tt *testing.T) {
+		s, _ := New[float64](20, 8, 3)
+		r := rand.New(rand.NewPCG(9, 10))
+		batch := [][]float64{randomVector(r, 20), randomVector(r, 20), randomVector(r, 20)}
+		out, err := s.ProjectBatch(batch)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for i, x := range batch {
+			y, _ := s.Project(x)
+			if cmp.Equal(out[i], y, cmpopts.EquateApprox(0, 1e-12)) != true {
+				tt.Errorf("%v != %v", out[i], y)
+			}
+		}
+	})
+	t.Run("noalloc", func(tt *testing.T) {
+		s, _ := New[float64](100, 10, 3)
+		x, y := make([]float64, 100), make([]float64, 10)
+		allocs := testing.AllocsPerRun(100, func() {
+			s.ProjectTo(x, y)
+		})
+		if allocs != 0 {
+			tt.Errorf("expect no allocation: %v", allocs)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := New[float64](0, 1, 0); errors.Is(err, ErrInvalidDim) != true {
+			tt.Errorf("expect ErrInvalidDim: %+v", err)
+		}
+		if _, err := New[float64](10, 17, 0); errors.Is(err, ErrInvalidDim) != true {
+			tt.Errorf("expect ErrInvalidDim: %+v", err)
+		}
+		s, _ := New[float64](10, 4, 0)
+		if _, err := s.Project(make([]float64, 9)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := s.ProjectBatch([][]float64{make([]float64, 10), nil}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}
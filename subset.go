@@ -0,0 +1,266 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// ZetaOr applies the subset sum (zeta) transform in place, in[S] becomes the sum of in[T] for every subset T of S.
+// Sets are bit masks, len(in) must be 2^n.
+func ZetaOr[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j+half] += in[j]
+			}
+		}
+	}
+}
+
+// MobiusOr applies the inverse of ZetaOr in place.
+func MobiusOr[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j+half] -= in[j]
+			}
+		}
+	}
+}
+
+// ZetaAnd applies the superset sum (zeta) transform in place, in[S] becomes the sum of in[T] for every superset T of S.
+func ZetaAnd[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j] += in[j+half]
+			}
+		}
+	}
+}
+
+// MobiusAnd applies the inverse of ZetaAnd in place.
+func MobiusAnd[T SignedInt](in []T) {
+	n := len(in)
+	if isPowerOfTwo(n) != true {
+		return
+	}
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j] -= in[j+half]
+			}
+		}
+	}
+}
+
+// OrConvolve returns the OR convolution of a and b, c[k] = sum of a[i]*b[j] for i|j == k.
+func OrConvolve[T SignedInt](a, b []T) ([]T, error) {
+	return pointwise(a, b, ZetaOr[T], MobiusOr[T])
+}
+
+// AndConvolve returns the AND convolution of a and b, c[k] = sum of a[i]*b[j] for i&j == k.
+func AndConvolve[T SignedInt](a, b []T) ([]T, error) {
+	return pointwise(a, b, ZetaAnd[T], MobiusAnd[T])
+}
+
+// SubsetConvolve returns the subset convolution of a and b, c[S] = sum of a[T]*b[S\T] for every subset T of S,
+// in O(n^2 2^n) by ranked zeta transform.
+func SubsetConvolve[T SignedInt](a, b []T) ([]T, error) {
+	size := len(a)
+	if err := checkLength(size); err != nil {
+		return nil, err
+	}
+	if len(b) != size {
+		return nil, ErrLengthMismatch
+	}
+
+	rank := bits.Len(uint(size)) - 1
+	ra := ranked(a, rank)
+	rb := ranked(b, rank)
+	for r := 0; r <= rank; r += 1 {
+		ZetaOr(ra[r])
+		ZetaOr(rb[r])
+	}
+
+	c := make([]T, size)
+	h := make([]T, size)
+	for r := 0; r <= rank; r += 1 {
+		clear(h)
+		for i := 0; i <= r; i += 1 {
+			for s := range h {
+				h[s] += ra[i][s] * rb[r-i][s]
+			}
+		}
+		MobiusOr(h)
+		for s := range c {
+			if bits.OnesCount(uint(s)) == r {
+				c[s] = h[s]
+			}
+		}
+	}
+	return c, nil
+}
+
+func pointwise[T SignedInt](a, b []T, forward, inverse func([]T)) ([]T, error) {
+	n := len(a)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, ErrLengthMismatch
+	}
+
+	fa := append([]T(nil), a...)
+	fb := append([]T(nil), b...)
+	forward(fa)
+	forward(fb)
+	for i := range fa {
+		fa[i] *= fb[i]
+	}
+	inverse(fa)
+	return fa, nil
+}
+
+func ranked[T any](in []T, rank int) [][]T {
+	r := make([][]T, rank+1)
+	for i := range r {
+		r[i] = make([]T, len(in))
+	}
+	for s, v := range in {
+		r[bits.OnesCount(uint(s))][s] = v
+	}
+	return r
+}
+
+// OrConvolveMod is OrConvolve modulo mod.
+func OrConvolveMod(a, b []uint64, mod uint64) ([]uint64, error) {
+	return pointwiseMod(a, b, mod, zetaOrMod, mobiusOrMod)
+}
+
+// AndConvolveMod is AndConvolve modulo mod.
+func AndConvolveMod(a, b []uint64, mod uint64) ([]uint64, error) {
+	return pointwiseMod(a, b, mod, zetaAndMod, mobiusAndMod)
+}
+
+// SubsetConvolveMod is SubsetConvolve modulo mod.
+func SubsetConvolveMod(a, b []uint64, mod uint64) ([]uint64, error) {
+	size := len(a)
+	if err := checkLength(size); err != nil {
+		return nil, err
+	}
+	if len(b) != size {
+		return nil, ErrLengthMismatch
+	}
+	if mod < 2 {
+		return nil, ErrInvalidModulus
+	}
+
+	rank := bits.Len(uint(size)) - 1
+	ra := ranked(a, rank)
+	rb := ranked(b, rank)
+	for r := 0; r <= rank; r += 1 {
+		reduceMod(ra[r], mod)
+		reduceMod(rb[r], mod)
+		zetaOrMod(ra[r], mod)
+		zetaOrMod(rb[r], mod)
+	}
+
+	c := make([]uint64, size)
+	h := make([]uint64, size)
+	for r := 0; r <= rank; r += 1 {
+		clear(h)
+		for i := 0; i <= r; i += 1 {
+			for s := range h {
+				h[s] = addMod(h[s], mulMod(ra[i][s], rb[r-i][s], mod), mod)
+			}
+		}
+		mobiusOrMod(h, mod)
+		for s := range c {
+			if bits.OnesCount(uint(s)) == r {
+				c[s] = h[s]
+			}
+		}
+	}
+	return c, nil
+}
+
+func pointwiseMod(a, b []uint64, mod uint64, forward, inverse func([]uint64, uint64)) ([]uint64, error) {
+	n := len(a)
+	if err := checkLength(n); err != nil {
+		return nil, err
+	}
+	if len(b) != n {
+		return nil, ErrLengthMismatch
+	}
+	if mod < 2 {
+		return nil, ErrInvalidModulus
+	}
+
+	fa := append([]uint64(nil), a...)
+	fb := append([]uint64(nil), b...)
+	reduceMod(fa, mod)
+	reduceMod(fb, mod)
+	forward(fa, mod)
+	forward(fb, mod)
+	for i := range fa {
+		fa[i] = mulMod(fa[i], fb[i], mod)
+	}
+	inverse(fa, mod)
+	return fa, nil
+}
+
+func zetaOrMod(in []uint64, mod uint64) {
+	n := len(in)
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j+half] = addMod(in[j+half], in[j], mod)
+			}
+		}
+	}
+}
+
+func mobiusOrMod(in []uint64, mod uint64) {
+	n := len(in)
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j+half] = subMod(in[j+half], in[j], mod)
+			}
+		}
+	}
+}
+
+func zetaAndMod(in []uint64, mod uint64) {
+	n := len(in)
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j] = addMod(in[j], in[j+half], mod)
+			}
+		}
+	}
+}
+
+func mobiusAndMod(in []uint64, mod uint64) {
+	n := len(in)
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				in[j] = subMod(in[j], in[j+half], mod)
+			}
+		}
+	}
+}
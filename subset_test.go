@@ -0,0 +1,155 @@
+package wht
+
+import (
+	"errors"
+	"math/big"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestZeta(t *testing.T) {
+	t.Run("or", func(tt *testing.T) {
+		x := []int32{1, 2, 3, 4}
+		ZetaOr(x)
+		// {}, {0}, {1}, {0,1}
+		expect1 := []int32{1, 3, 4, 10}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		MobiusOr(x)
+		expect2 := []int32{1, 2, 3, 4}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("and", func(tt *testing.T) {
+		x := []int32{1, 2, 3, 4}
+		ZetaAnd(x)
+		expect1 := []int32{10, 6, 7, 4}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		MobiusAnd(x)
+		expect2 := []int32{1, 2, 3, 4}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+}
+
+func TestSetConvolve(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	random := func(n int) []int64 {
+		x := make([]int64, n)
+		for i := range x {
+			x[i] = r.Int64N(200) - 100
+		}
+		return x
+	}
+
+	for _, n := range []int{1, 2, 8, 64} {
+		a, b := random(n), random(n)
+		or := make([]int64, n)
+		and := make([]int64, n)
+		subset := make([]int64, n)
+		for i := 0; i < n; i += 1 {
+			for j := 0; j < n; j += 1 {
+				or[i|j] += a[i] * b[j]
+				and[i&j] += a[i] * b[j]
+				if i&j == 0 {
+					subset[i|j] += a[i] * b[j]
+				}
+			}
+		}
+
+		c, err := OrConvolve(a, b)
+		if err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(c, or) != true {
+			t.Errorf("or n=%d: %v != %v", n, c, or)
+		}
+		c, err = AndConvolve(a, b)
+		if err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(c, and) != true {
+			t.Errorf("and n=%d: %v != %v", n, c, and)
+		}
+		c, err = SubsetConvolve(a, b)
+		if err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(c, subset) != true {
+			t.Errorf("subset n=%d: %v != %v", n, c, subset)
+		}
+	}
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := OrConvolve([]int16{1, 2}, []int16{1}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := SubsetConvolve([]int16{1, 2, 3}, []int16{1, 2, 3}); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestSetConvolveMod(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	mod := uint64(1<<62 + 57)
+	n := 16
+	a := make([]uint64, n)
+	b := make([]uint64, n)
+	for i := range a {
+		a[i] = r.Uint64()
+		b[i] = r.Uint64()
+	}
+
+	m := new(big.Int).SetUint64(mod)
+	or := make([]*big.Int, n)
+	and := make([]*big.Int, n)
+	subset := make([]*big.Int, n)
+	for i := range or {
+		or[i], and[i], subset[i] = new(big.Int), new(big.Int), new(big.Int)
+	}
+	for i := 0; i < n; i += 1 {
+		for j := 0; j < n; j += 1 {
+			p := new(big.Int).Mul(new(big.Int).SetUint64(a[i]), new(big.Int).SetUint64(b[j]))
+			or[i|j].Add(or[i|j], p)
+			and[i&j].Add(and[i&j], p)
+			if i&j == 0 {
+				subset[i|j].Add(subset[i|j], p)
+			}
+		}
+	}
+	check := func(name string, c []uint64, expect []*big.Int) {
+		for i := range c {
+			e := new(big.Int).Mod(expect[i], m).Uint64()
+			if c[i] != e {
+				t.Errorf("%s [%d] %d != %d", name, i, c[i], e)
+			}
+		}
+	}
+
+	c, err := OrConvolveMod(a, b, mod)
+	if err != nil {
+		t.Fatalf("no error: %+v", err)
+	}
+	check("or", c, or)
+	c, err = AndConvolveMod(a, b, mod)
+	if err != nil {
+		t.Fatalf("no error: %+v", err)
+	}
+	check("and", c, and)
+	c, err = SubsetConvolveMod(a, b, mod)
+	if err != nil {
+		t.Fatalf("no error: %+v", err)
+	}
+	check("subset", c, subset)
+
+	if _, err := OrConvolveMod(a, b, 1); errors.Is(err, ErrInvalidModulus) != true {
+		t.Errorf("expect ErrInvalidModulus: %+v", err)
+	}
+}
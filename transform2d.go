@@ -0,0 +1,122 @@
+package wht
+
+// Transform2D applies separable Walsh-Hadamard Transform to a matrix.
+// Every row and every column is transformed, and both axes are reordered to Sequency Order.
+// The number of rows and columns must be 2^n, and all rows must have the same length.
+func Transform2D[T Signed](in [][]T) {
+	rows, cols, ok := matrixSize(in)
+	if ok != true {
+		return
+	}
+
+	for i := 0; i < rows; i += 1 {
+		Transform(in[i])
+	}
+	column := make([]T, rows)
+	for c := 0; c < cols; c += 1 {
+		for r := 0; r < rows; r += 1 {
+			column[r] = in[r][c]
+		}
+		Transform(column)
+		for r := 0; r < rows; r += 1 {
+			in[r][c] = column[r]
+		}
+	}
+}
+
+// Invert2D applies Inverse Walsh-Hadamard Transform to a matrix produced by Transform2D.
+func Invert2D[T Signed](in [][]T) {
+	rows, cols, ok := matrixSize(in)
+	if ok != true {
+		return
+	}
+
+	column := make([]T, rows)
+	for c := 0; c < cols; c += 1 {
+		for r := 0; r < rows; r += 1 {
+			column[r] = in[r][c]
+		}
+		Invert(column)
+		for r := 0; r < rows; r += 1 {
+			in[r][c] = column[r]
+		}
+	}
+	for i := 0; i < rows; i += 1 {
+		Invert(in[i])
+	}
+}
+
+// TransformPlane applies separable Walsh-Hadamard Transform to a width x height region
+// of a flat buffer whose rows are stride elements apart, such as the planes of image.YCbCr.
+// width and height must be 2^n.
+func TransformPlane[T Signed](in []T, width, height, stride int) {
+	if isPlane(in, width, height, stride) != true {
+		return
+	}
+
+	for y := 0; y < height; y += 1 {
+		Transform(in[y*stride : y*stride+width])
+	}
+	column := make([]T, height)
+	for x := 0; x < width; x += 1 {
+		for y := 0; y < height; y += 1 {
+			column[y] = in[y*stride+x]
+		}
+		Transform(column)
+		for y := 0; y < height; y += 1 {
+			in[y*stride+x] = column[y]
+		}
+	}
+}
+
+// InvertPlane applies Inverse Walsh-Hadamard Transform to a region produced by TransformPlane.
+func InvertPlane[T Signed](in []T, width, height, stride int) {
+	if isPlane(in, width, height, stride) != true {
+		return
+	}
+
+	column := make([]T, height)
+	for x := 0; x < width; x += 1 {
+		for y := 0; y < height; y += 1 {
+			column[y] = in[y*stride+x]
+		}
+		Invert(column)
+		for y := 0; y < height; y += 1 {
+			in[y*stride+x] = column[y]
+		}
+	}
+	for y := 0; y < height; y += 1 {
+		Invert(in[y*stride : y*stride+width])
+	}
+}
+
+func matrixSize[T Signed](in [][]T) (int, int, bool) {
+	rows := len(in)
+	if isPowerOfTwo(rows) != true {
+		return 0, 0, false
+	}
+	cols := len(in[0])
+	if isPowerOfTwo(cols) != true {
+		return 0, 0, false
+	}
+	for i := 1; i < rows; i += 1 {
+		if len(in[i]) != cols {
+			return 0, 0, false
+		}
+	}
+	return rows, cols, true
+}
+
+func isPlane[T Signed](in []T, width, height, stride int) bool {
+	if isPowerOfTwo(width) != true || isPowerOfTwo(height) != true {
+		return false
+	}
+	if stride < width {
+		return false
+	}
+	return (height-1)*stride+width <= len(in)
+}
+
+func isPowerOfTwo(n int) bool {
+	return 0 < n && (n&(n-1)) == 0
+}
@@ -0,0 +1,117 @@
+package wht
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransform2D(t *testing.T) {
+	t.Run("dc", func(tt *testing.T) {
+		x := [][]int16{
+			{3, 3, 3, 3},
+			{3, 3, 3, 3},
+		}
+		Transform2D(x)
+		expect1 := [][]int16{
+			{24, 0, 0, 0},
+			{0, 0, 0, 0},
+		}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		Invert2D(x)
+		expect2 := [][]int16{
+			{3, 3, 3, 3},
+			{3, 3, 3, 3},
+		}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("separable", func(tt *testing.T) {
+		x := [][]int32{
+			{10, 20, 30, 40},
+			{50, 60, 70, 80},
+			{90, 85, 75, 65},
+			{55, 45, 35, 25},
+		}
+		Transform2D(x)
+
+		// rows then columns with 1D Transform
+		expect := [][]int32{
+			{10, 20, 30, 40},
+			{50, 60, 70, 80},
+			{90, 85, 75, 65},
+			{55, 45, 35, 25},
+		}
+		for i := range expect {
+			Transform(expect[i])
+		}
+		for c := 0; c < 4; c += 1 {
+			col := []int32{expect[0][c], expect[1][c], expect[2][c], expect[3][c]}
+			Transform(col)
+			for r := 0; r < 4; r += 1 {
+				expect[r][c] = col[r]
+			}
+		}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		x := [][]int16{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		Transform2D(x)
+		expect := [][]int16{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+}
+
+func TestTransformPlane(t *testing.T) {
+	t.Run("stride", func(tt *testing.T) {
+		// 4x2 region in a stride 6 buffer, padding must be untouched
+		x := []int16{
+			1, 2, 3, 4, -1, -1,
+			5, 6, 7, 8, -1, -1,
+		}
+		TransformPlane(x, 4, 2, 6)
+
+		m := [][]int16{
+			{1, 2, 3, 4},
+			{5, 6, 7, 8},
+		}
+		Transform2D(m)
+		expect1 := []int16{
+			m[0][0], m[0][1], m[0][2], m[0][3], -1, -1,
+			m[1][0], m[1][1], m[1][2], m[1][3], -1, -1,
+		}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+
+		InvertPlane(x, 4, 2, 6)
+		expect2 := []int16{
+			1, 2, 3, 4, -1, -1,
+			5, 6, 7, 8, -1, -1,
+		}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("short", func(tt *testing.T) {
+		x := []int16{1, 2, 3, 4, 5, 6}
+		TransformPlane(x, 4, 2, 4)
+		expect := []int16{1, 2, 3, 4, 5, 6}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+}
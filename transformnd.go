@@ -0,0 +1,113 @@
+package wht
+
+// TransformND applies separable Walsh-Hadamard Transform to a multidimensional array held in a flat buffer.
+// Element (i0, i1, ...) is data[i0*strides[0] + i1*strides[1] + ...], and every shape[axis] must be 2^n.
+// Only the given axes are transformed, all axes when axes is nil; an axis must not appear twice.
+// The coefficients along each axis are reordered to Sequency Order.
+func TransformND[T Signed](data []T, shape, strides, axes []int) error {
+	return transformND(data, shape, strides, axes, (*Plan[T]).Forward)
+}
+
+// InvertND applies Inverse Walsh-Hadamard Transform to a multidimensional array produced by TransformND.
+func InvertND[T Signed](data []T, shape, strides, axes []int) error {
+	return transformND(data, shape, strides, axes, (*Plan[T]).Inverse)
+}
+
+// ContiguousStrides returns the row-major strides of a densely packed array of the given shape,
+// the last axis varies fastest.
+func ContiguousStrides(shape []int) []int {
+	strides := make([]int, len(shape))
+	s := 1
+	for i := len(shape) - 1; 0 <= i; i -= 1 {
+		strides[i] = s
+		s *= shape[i]
+	}
+	return strides
+}
+
+func transformND[T Signed](data []T, shape, strides, axes []int, fn func(*Plan[T], []T) error) error {
+	if len(shape) < 1 {
+		return ErrEmpty
+	}
+	if len(shape) != len(strides) {
+		return ErrLengthMismatch
+	}
+	last := 0
+	for i, size := range shape {
+		if err := checkLength(size); err != nil {
+			return err
+		}
+		if strides[i] < 1 {
+			return ErrLengthMismatch
+		}
+		last += (size - 1) * strides[i]
+	}
+	if len(data) <= last {
+		return ErrLengthMismatch
+	}
+	if axes == nil {
+		axes = make([]int, len(shape))
+		for i := range axes {
+			axes[i] = i
+		}
+	}
+	seen := make([]bool, len(shape))
+	for _, axis := range axes {
+		if axis < 0 || len(shape) <= axis {
+			return ErrOutOfRange
+		}
+		if seen[axis] {
+			return ErrDuplicateAxis
+		}
+		seen[axis] = true
+	}
+
+	index := make([]int, len(shape))
+	for _, axis := range axes {
+		n := shape[axis]
+		p, err := NewPlan[T](n, OrderSequency)
+		if err != nil {
+			return err
+		}
+		line := make([]T, n)
+		stride := strides[axis]
+
+		// visit every line along axis, index[axis] stays 0
+		clear(index)
+		for {
+			offset := 0
+			for i, v := range index {
+				offset += v * strides[i]
+			}
+			for k := 0; k < n; k += 1 {
+				line[k] = data[offset+k*stride]
+			}
+			if err := fn(p, line); err != nil {
+				return err
+			}
+			for k := 0; k < n; k += 1 {
+				data[offset+k*stride] = line[k]
+			}
+
+			if nextIndex(index, shape, axis) != true {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// nextIndex advances index over shape except skip axis, it reports false after the last index.
+func nextIndex(index, shape []int, skip int) bool {
+	for i := len(index) - 1; 0 <= i; i -= 1 {
+		if i == skip {
+			continue
+		}
+		index[i] += 1
+		if index[i] < shape[i] {
+			return true
+		}
+		index[i] = 0
+	}
+	return false
+}
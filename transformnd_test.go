@@ -0,0 +1,138 @@
+package wht
+
+import (
+	"errors"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformND(t *testing.T) {
+	t.Run("2d", func(tt *testing.T) {
+		// same as Transform2D
+		x := []int32{
+			10, 20, 30, 40,
+			50, 60, 70, 80,
+		}
+		if err := TransformND(x, []int{2, 4}, ContiguousStrides([]int{2, 4}), nil); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		m := [][]int32{
+			{10, 20, 30, 40},
+			{50, 60, 70, 80},
+		}
+		Transform2D(m)
+		expect := append(append([]int32(nil), m[0]...), m[1]...)
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("3d", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		shape := []int{4, 2, 8}
+		strides := ContiguousStrides(shape)
+		x := make([]int32, 4*2*8)
+		for i := range x {
+			x[i] = r.Int32N(256) - 128
+		}
+		orig := append([]int32(nil), x...)
+
+		if err := TransformND(x, shape, strides, nil); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+
+		// separable: coefficient (u, v, w) = sum x(i, j, k) * W_u(i) * W_v(j) * W_w(k)
+		walsh := func(k, n int) []int32 {
+			w, err := WalshFunction[int32](k, n, OrderSequency)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			return w
+		}
+		for u := 0; u < 4; u += 1 {
+			for v := 0; v < 2; v += 1 {
+				for w := 0; w < 8; w += 1 {
+					wu, wv, ww := walsh(u, 4), walsh(v, 2), walsh(w, 8)
+					sum := int32(0)
+					for i := 0; i < 4; i += 1 {
+						for j := 0; j < 2; j += 1 {
+							for k := 0; k < 8; k += 1 {
+								sum += orig[i*16+j*8+k] * wu[i] * wv[j] * ww[k]
+							}
+						}
+					}
+					if got := x[u*16+v*8+w]; got != sum {
+						tt.Errorf("(%d,%d,%d) %d != %d", u, v, w, got, sum)
+					}
+				}
+			}
+		}
+
+		if err := InvertND(x, shape, strides, nil); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, orig) != true {
+			tt.Errorf("%v != %v", x, orig)
+		}
+	})
+	t.Run("axes", func(tt *testing.T) {
+		// transform only time axis of (t, y, x) cube
+		shape := []int{4, 2, 2}
+		x := []int16{
+			1, 2, 3, 4,
+			5, 6, 7, 8,
+			9, 10, 11, 12,
+			13, 14, 15, 16,
+		}
+		if err := TransformND(x, shape, ContiguousStrides(shape), []int{0}); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for p := 0; p < 4; p += 1 {
+			line := []int16{int16(1 + p), int16(5 + p), int16(9 + p), int16(13 + p)}
+			Transform(line)
+			got := []int16{x[p], x[4+p], x[8+p], x[12+p]}
+			if cmp.Equal(got, line) != true {
+				tt.Errorf("pixel %d: %v != %v", p, got, line)
+			}
+		}
+	})
+	t.Run("strided", func(tt *testing.T) {
+		// 2x2 block inside a padded buffer
+		x := []int16{
+			1, 2, -1,
+			3, 4, -1,
+		}
+		if err := TransformND(x, []int{2, 2}, []int{3, 1}, nil); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{
+			10, -2, -1,
+			-4, 0, -1,
+		}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		x := make([]int16, 12)
+		if err := TransformND(x, []int{3, 4}, []int{4, 1}, nil); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if err := TransformND(x, []int{4, 4}, []int{4, 1}, nil); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := TransformND(x, []int{2, 4}, []int{1}, nil); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := TransformND(x, []int{2, 4}, []int{4, 1}, []int{2}); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if err := TransformND(x, []int{2, 4}, []int{4, 1}, []int{0, 1, 0}); errors.Is(err, ErrDuplicateAxis) != true {
+			tt.Errorf("expect ErrDuplicateAxis: %+v", err)
+		}
+		if err := TransformND(x, nil, nil, nil); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
@@ -7,3 +7,7 @@ type SignedInt interface {
 type Signed interface {
 	~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
 }
+
+type Float interface {
+	~float32 | ~float64
+}
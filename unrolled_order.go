@@ -0,0 +1,2723 @@
+// Code generated by cmd/gen.go; DO NOT EDIT.
+
+package wht
+
+func transform4Natural[T SignedInt](in [4]T) [4]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+
+	return [4]T{
+		a0 + a2, // Nat 0
+		a1 + a3, // Nat 1
+		a0 - a2, // Nat 2
+		a1 - a3, // Nat 3
+	}
+}
+
+func transform8Natural[T SignedInt](in [8]T) [8]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+
+	return [8]T{
+		b0 + b4, // Nat 0
+		b1 + b5, // Nat 1
+		b2 + b6, // Nat 2
+		b3 + b7, // Nat 3
+		b0 - b4, // Nat 4
+		b1 - b5, // Nat 5
+		b2 - b6, // Nat 6
+		b3 - b7, // Nat 7
+	}
+}
+
+func transform16Natural[T SignedInt](in [16]T) [16]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+
+	return [16]T{
+		c0 + c8,  // Nat 0
+		c1 + c9,  // Nat 1
+		c2 + c10, // Nat 2
+		c3 + c11, // Nat 3
+		c4 + c12, // Nat 4
+		c5 + c13, // Nat 5
+		c6 + c14, // Nat 6
+		c7 + c15, // Nat 7
+		c0 - c8,  // Nat 8
+		c1 - c9,  // Nat 9
+		c2 - c10, // Nat 10
+		c3 - c11, // Nat 11
+		c4 - c12, // Nat 12
+		c5 - c13, // Nat 13
+		c6 - c14, // Nat 14
+		c7 - c15, // Nat 15
+	}
+}
+
+func transform32Natural[T SignedInt](in [32]T) [32]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+
+	return [32]T{
+		d0 + d16,  // Nat 0
+		d1 + d17,  // Nat 1
+		d2 + d18,  // Nat 2
+		d3 + d19,  // Nat 3
+		d4 + d20,  // Nat 4
+		d5 + d21,  // Nat 5
+		d6 + d22,  // Nat 6
+		d7 + d23,  // Nat 7
+		d8 + d24,  // Nat 8
+		d9 + d25,  // Nat 9
+		d10 + d26, // Nat 10
+		d11 + d27, // Nat 11
+		d12 + d28, // Nat 12
+		d13 + d29, // Nat 13
+		d14 + d30, // Nat 14
+		d15 + d31, // Nat 15
+		d0 - d16,  // Nat 16
+		d1 - d17,  // Nat 17
+		d2 - d18,  // Nat 18
+		d3 - d19,  // Nat 19
+		d4 - d20,  // Nat 20
+		d5 - d21,  // Nat 21
+		d6 - d22,  // Nat 22
+		d7 - d23,  // Nat 23
+		d8 - d24,  // Nat 24
+		d9 - d25,  // Nat 25
+		d10 - d26, // Nat 26
+		d11 - d27, // Nat 27
+		d12 - d28, // Nat 28
+		d13 - d29, // Nat 29
+		d14 - d30, // Nat 30
+		d15 - d31, // Nat 31
+	}
+}
+
+func transform64Natural[T SignedInt](in [64]T) [64]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+	a32 := in[32] + in[33]
+	a33 := in[32] - in[33]
+	a34 := in[34] + in[35]
+	a35 := in[34] - in[35]
+	a36 := in[36] + in[37]
+	a37 := in[36] - in[37]
+	a38 := in[38] + in[39]
+	a39 := in[38] - in[39]
+	a40 := in[40] + in[41]
+	a41 := in[40] - in[41]
+	a42 := in[42] + in[43]
+	a43 := in[42] - in[43]
+	a44 := in[44] + in[45]
+	a45 := in[44] - in[45]
+	a46 := in[46] + in[47]
+	a47 := in[46] - in[47]
+	a48 := in[48] + in[49]
+	a49 := in[48] - in[49]
+	a50 := in[50] + in[51]
+	a51 := in[50] - in[51]
+	a52 := in[52] + in[53]
+	a53 := in[52] - in[53]
+	a54 := in[54] + in[55]
+	a55 := in[54] - in[55]
+	a56 := in[56] + in[57]
+	a57 := in[56] - in[57]
+	a58 := in[58] + in[59]
+	a59 := in[58] - in[59]
+	a60 := in[60] + in[61]
+	a61 := in[60] - in[61]
+	a62 := in[62] + in[63]
+	a63 := in[62] - in[63]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+	b32 := a32 + a34
+	b33 := a33 + a35
+	b34 := a32 - a34
+	b35 := a33 - a35
+	b36 := a36 + a38
+	b37 := a37 + a39
+	b38 := a36 - a38
+	b39 := a37 - a39
+	b40 := a40 + a42
+	b41 := a41 + a43
+	b42 := a40 - a42
+	b43 := a41 - a43
+	b44 := a44 + a46
+	b45 := a45 + a47
+	b46 := a44 - a46
+	b47 := a45 - a47
+	b48 := a48 + a50
+	b49 := a49 + a51
+	b50 := a48 - a50
+	b51 := a49 - a51
+	b52 := a52 + a54
+	b53 := a53 + a55
+	b54 := a52 - a54
+	b55 := a53 - a55
+	b56 := a56 + a58
+	b57 := a57 + a59
+	b58 := a56 - a58
+	b59 := a57 - a59
+	b60 := a60 + a62
+	b61 := a61 + a63
+	b62 := a60 - a62
+	b63 := a61 - a63
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+	c32 := b32 + b36
+	c33 := b33 + b37
+	c34 := b34 + b38
+	c35 := b35 + b39
+	c36 := b32 - b36
+	c37 := b33 - b37
+	c38 := b34 - b38
+	c39 := b35 - b39
+	c40 := b40 + b44
+	c41 := b41 + b45
+	c42 := b42 + b46
+	c43 := b43 + b47
+	c44 := b40 - b44
+	c45 := b41 - b45
+	c46 := b42 - b46
+	c47 := b43 - b47
+	c48 := b48 + b52
+	c49 := b49 + b53
+	c50 := b50 + b54
+	c51 := b51 + b55
+	c52 := b48 - b52
+	c53 := b49 - b53
+	c54 := b50 - b54
+	c55 := b51 - b55
+	c56 := b56 + b60
+	c57 := b57 + b61
+	c58 := b58 + b62
+	c59 := b59 + b63
+	c60 := b56 - b60
+	c61 := b57 - b61
+	c62 := b58 - b62
+	c63 := b59 - b63
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+	d32 := c32 + c40
+	d33 := c33 + c41
+	d34 := c34 + c42
+	d35 := c35 + c43
+	d36 := c36 + c44
+	d37 := c37 + c45
+	d38 := c38 + c46
+	d39 := c39 + c47
+	d40 := c32 - c40
+	d41 := c33 - c41
+	d42 := c34 - c42
+	d43 := c35 - c43
+	d44 := c36 - c44
+	d45 := c37 - c45
+	d46 := c38 - c46
+	d47 := c39 - c47
+	d48 := c48 + c56
+	d49 := c49 + c57
+	d50 := c50 + c58
+	d51 := c51 + c59
+	d52 := c52 + c60
+	d53 := c53 + c61
+	d54 := c54 + c62
+	d55 := c55 + c63
+	d56 := c48 - c56
+	d57 := c49 - c57
+	d58 := c50 - c58
+	d59 := c51 - c59
+	d60 := c52 - c60
+	d61 := c53 - c61
+	d62 := c54 - c62
+	d63 := c55 - c63
+
+	e0 := d0 + d16
+	e1 := d1 + d17
+	e2 := d2 + d18
+	e3 := d3 + d19
+	e4 := d4 + d20
+	e5 := d5 + d21
+	e6 := d6 + d22
+	e7 := d7 + d23
+	e8 := d8 + d24
+	e9 := d9 + d25
+	e10 := d10 + d26
+	e11 := d11 + d27
+	e12 := d12 + d28
+	e13 := d13 + d29
+	e14 := d14 + d30
+	e15 := d15 + d31
+	e16 := d0 - d16
+	e17 := d1 - d17
+	e18 := d2 - d18
+	e19 := d3 - d19
+	e20 := d4 - d20
+	e21 := d5 - d21
+	e22 := d6 - d22
+	e23 := d7 - d23
+	e24 := d8 - d24
+	e25 := d9 - d25
+	e26 := d10 - d26
+	e27 := d11 - d27
+	e28 := d12 - d28
+	e29 := d13 - d29
+	e30 := d14 - d30
+	e31 := d15 - d31
+	e32 := d32 + d48
+	e33 := d33 + d49
+	e34 := d34 + d50
+	e35 := d35 + d51
+	e36 := d36 + d52
+	e37 := d37 + d53
+	e38 := d38 + d54
+	e39 := d39 + d55
+	e40 := d40 + d56
+	e41 := d41 + d57
+	e42 := d42 + d58
+	e43 := d43 + d59
+	e44 := d44 + d60
+	e45 := d45 + d61
+	e46 := d46 + d62
+	e47 := d47 + d63
+	e48 := d32 - d48
+	e49 := d33 - d49
+	e50 := d34 - d50
+	e51 := d35 - d51
+	e52 := d36 - d52
+	e53 := d37 - d53
+	e54 := d38 - d54
+	e55 := d39 - d55
+	e56 := d40 - d56
+	e57 := d41 - d57
+	e58 := d42 - d58
+	e59 := d43 - d59
+	e60 := d44 - d60
+	e61 := d45 - d61
+	e62 := d46 - d62
+	e63 := d47 - d63
+
+	return [64]T{
+		e0 + e32,  // Nat 0
+		e1 + e33,  // Nat 1
+		e2 + e34,  // Nat 2
+		e3 + e35,  // Nat 3
+		e4 + e36,  // Nat 4
+		e5 + e37,  // Nat 5
+		e6 + e38,  // Nat 6
+		e7 + e39,  // Nat 7
+		e8 + e40,  // Nat 8
+		e9 + e41,  // Nat 9
+		e10 + e42, // Nat 10
+		e11 + e43, // Nat 11
+		e12 + e44, // Nat 12
+		e13 + e45, // Nat 13
+		e14 + e46, // Nat 14
+		e15 + e47, // Nat 15
+		e16 + e48, // Nat 16
+		e17 + e49, // Nat 17
+		e18 + e50, // Nat 18
+		e19 + e51, // Nat 19
+		e20 + e52, // Nat 20
+		e21 + e53, // Nat 21
+		e22 + e54, // Nat 22
+		e23 + e55, // Nat 23
+		e24 + e56, // Nat 24
+		e25 + e57, // Nat 25
+		e26 + e58, // Nat 26
+		e27 + e59, // Nat 27
+		e28 + e60, // Nat 28
+		e29 + e61, // Nat 29
+		e30 + e62, // Nat 30
+		e31 + e63, // Nat 31
+		e0 - e32,  // Nat 32
+		e1 - e33,  // Nat 33
+		e2 - e34,  // Nat 34
+		e3 - e35,  // Nat 35
+		e4 - e36,  // Nat 36
+		e5 - e37,  // Nat 37
+		e6 - e38,  // Nat 38
+		e7 - e39,  // Nat 39
+		e8 - e40,  // Nat 40
+		e9 - e41,  // Nat 41
+		e10 - e42, // Nat 42
+		e11 - e43, // Nat 43
+		e12 - e44, // Nat 44
+		e13 - e45, // Nat 45
+		e14 - e46, // Nat 46
+		e15 - e47, // Nat 47
+		e16 - e48, // Nat 48
+		e17 - e49, // Nat 49
+		e18 - e50, // Nat 50
+		e19 - e51, // Nat 51
+		e20 - e52, // Nat 52
+		e21 - e53, // Nat 53
+		e22 - e54, // Nat 54
+		e23 - e55, // Nat 55
+		e24 - e56, // Nat 56
+		e25 - e57, // Nat 57
+		e26 - e58, // Nat 58
+		e27 - e59, // Nat 59
+		e28 - e60, // Nat 60
+		e29 - e61, // Nat 61
+		e30 - e62, // Nat 62
+		e31 - e63, // Nat 63
+	}
+}
+
+func invert4Natural[T SignedInt](in [4]T) [4]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+
+	return [4]T{
+		(a0 + a2) >> 2,
+		(a1 + a3) >> 2,
+		(a0 - a2) >> 2,
+		(a1 - a3) >> 2,
+	}
+}
+
+func invert8Natural[T SignedInt](in [8]T) [8]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+
+	return [8]T{
+		(b0 + b4) >> 3,
+		(b1 + b5) >> 3,
+		(b2 + b6) >> 3,
+		(b3 + b7) >> 3,
+		(b0 - b4) >> 3,
+		(b1 - b5) >> 3,
+		(b2 - b6) >> 3,
+		(b3 - b7) >> 3,
+	}
+}
+
+func invert16Natural[T SignedInt](in [16]T) [16]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+
+	return [16]T{
+		(c0 + c8) >> 4,
+		(c1 + c9) >> 4,
+		(c2 + c10) >> 4,
+		(c3 + c11) >> 4,
+		(c4 + c12) >> 4,
+		(c5 + c13) >> 4,
+		(c6 + c14) >> 4,
+		(c7 + c15) >> 4,
+		(c0 - c8) >> 4,
+		(c1 - c9) >> 4,
+		(c2 - c10) >> 4,
+		(c3 - c11) >> 4,
+		(c4 - c12) >> 4,
+		(c5 - c13) >> 4,
+		(c6 - c14) >> 4,
+		(c7 - c15) >> 4,
+	}
+}
+
+func invert32Natural[T SignedInt](in [32]T) [32]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+
+	return [32]T{
+		(d0 + d16) >> 5,
+		(d1 + d17) >> 5,
+		(d2 + d18) >> 5,
+		(d3 + d19) >> 5,
+		(d4 + d20) >> 5,
+		(d5 + d21) >> 5,
+		(d6 + d22) >> 5,
+		(d7 + d23) >> 5,
+		(d8 + d24) >> 5,
+		(d9 + d25) >> 5,
+		(d10 + d26) >> 5,
+		(d11 + d27) >> 5,
+		(d12 + d28) >> 5,
+		(d13 + d29) >> 5,
+		(d14 + d30) >> 5,
+		(d15 + d31) >> 5,
+		(d0 - d16) >> 5,
+		(d1 - d17) >> 5,
+		(d2 - d18) >> 5,
+		(d3 - d19) >> 5,
+		(d4 - d20) >> 5,
+		(d5 - d21) >> 5,
+		(d6 - d22) >> 5,
+		(d7 - d23) >> 5,
+		(d8 - d24) >> 5,
+		(d9 - d25) >> 5,
+		(d10 - d26) >> 5,
+		(d11 - d27) >> 5,
+		(d12 - d28) >> 5,
+		(d13 - d29) >> 5,
+		(d14 - d30) >> 5,
+		(d15 - d31) >> 5,
+	}
+}
+
+func invert64Natural[T SignedInt](in [64]T) [64]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+	a32 := in[32] + in[33]
+	a33 := in[32] - in[33]
+	a34 := in[34] + in[35]
+	a35 := in[34] - in[35]
+	a36 := in[36] + in[37]
+	a37 := in[36] - in[37]
+	a38 := in[38] + in[39]
+	a39 := in[38] - in[39]
+	a40 := in[40] + in[41]
+	a41 := in[40] - in[41]
+	a42 := in[42] + in[43]
+	a43 := in[42] - in[43]
+	a44 := in[44] + in[45]
+	a45 := in[44] - in[45]
+	a46 := in[46] + in[47]
+	a47 := in[46] - in[47]
+	a48 := in[48] + in[49]
+	a49 := in[48] - in[49]
+	a50 := in[50] + in[51]
+	a51 := in[50] - in[51]
+	a52 := in[52] + in[53]
+	a53 := in[52] - in[53]
+	a54 := in[54] + in[55]
+	a55 := in[54] - in[55]
+	a56 := in[56] + in[57]
+	a57 := in[56] - in[57]
+	a58 := in[58] + in[59]
+	a59 := in[58] - in[59]
+	a60 := in[60] + in[61]
+	a61 := in[60] - in[61]
+	a62 := in[62] + in[63]
+	a63 := in[62] - in[63]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+	b32 := a32 + a34
+	b33 := a33 + a35
+	b34 := a32 - a34
+	b35 := a33 - a35
+	b36 := a36 + a38
+	b37 := a37 + a39
+	b38 := a36 - a38
+	b39 := a37 - a39
+	b40 := a40 + a42
+	b41 := a41 + a43
+	b42 := a40 - a42
+	b43 := a41 - a43
+	b44 := a44 + a46
+	b45 := a45 + a47
+	b46 := a44 - a46
+	b47 := a45 - a47
+	b48 := a48 + a50
+	b49 := a49 + a51
+	b50 := a48 - a50
+	b51 := a49 - a51
+	b52 := a52 + a54
+	b53 := a53 + a55
+	b54 := a52 - a54
+	b55 := a53 - a55
+	b56 := a56 + a58
+	b57 := a57 + a59
+	b58 := a56 - a58
+	b59 := a57 - a59
+	b60 := a60 + a62
+	b61 := a61 + a63
+	b62 := a60 - a62
+	b63 := a61 - a63
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+	c32 := b32 + b36
+	c33 := b33 + b37
+	c34 := b34 + b38
+	c35 := b35 + b39
+	c36 := b32 - b36
+	c37 := b33 - b37
+	c38 := b34 - b38
+	c39 := b35 - b39
+	c40 := b40 + b44
+	c41 := b41 + b45
+	c42 := b42 + b46
+	c43 := b43 + b47
+	c44 := b40 - b44
+	c45 := b41 - b45
+	c46 := b42 - b46
+	c47 := b43 - b47
+	c48 := b48 + b52
+	c49 := b49 + b53
+	c50 := b50 + b54
+	c51 := b51 + b55
+	c52 := b48 - b52
+	c53 := b49 - b53
+	c54 := b50 - b54
+	c55 := b51 - b55
+	c56 := b56 + b60
+	c57 := b57 + b61
+	c58 := b58 + b62
+	c59 := b59 + b63
+	c60 := b56 - b60
+	c61 := b57 - b61
+	c62 := b58 - b62
+	c63 := b59 - b63
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+	d32 := c32 + c40
+	d33 := c33 + c41
+	d34 := c34 + c42
+	d35 := c35 + c43
+	d36 := c36 + c44
+	d37 := c37 + c45
+	d38 := c38 + c46
+	d39 := c39 + c47
+	d40 := c32 - c40
+	d41 := c33 - c41
+	d42 := c34 - c42
+	d43 := c35 - c43
+	d44 := c36 - c44
+	d45 := c37 - c45
+	d46 := c38 - c46
+	d47 := c39 - c47
+	d48 := c48 + c56
+	d49 := c49 + c57
+	d50 := c50 + c58
+	d51 := c51 + c59
+	d52 := c52 + c60
+	d53 := c53 + c61
+	d54 := c54 + c62
+	d55 := c55 + c63
+	d56 := c48 - c56
+	d57 := c49 - c57
+	d58 := c50 - c58
+	d59 := c51 - c59
+	d60 := c52 - c60
+	d61 := c53 - c61
+	d62 := c54 - c62
+	d63 := c55 - c63
+
+	e0 := d0 + d16
+	e1 := d1 + d17
+	e2 := d2 + d18
+	e3 := d3 + d19
+	e4 := d4 + d20
+	e5 := d5 + d21
+	e6 := d6 + d22
+	e7 := d7 + d23
+	e8 := d8 + d24
+	e9 := d9 + d25
+	e10 := d10 + d26
+	e11 := d11 + d27
+	e12 := d12 + d28
+	e13 := d13 + d29
+	e14 := d14 + d30
+	e15 := d15 + d31
+	e16 := d0 - d16
+	e17 := d1 - d17
+	e18 := d2 - d18
+	e19 := d3 - d19
+	e20 := d4 - d20
+	e21 := d5 - d21
+	e22 := d6 - d22
+	e23 := d7 - d23
+	e24 := d8 - d24
+	e25 := d9 - d25
+	e26 := d10 - d26
+	e27 := d11 - d27
+	e28 := d12 - d28
+	e29 := d13 - d29
+	e30 := d14 - d30
+	e31 := d15 - d31
+	e32 := d32 + d48
+	e33 := d33 + d49
+	e34 := d34 + d50
+	e35 := d35 + d51
+	e36 := d36 + d52
+	e37 := d37 + d53
+	e38 := d38 + d54
+	e39 := d39 + d55
+	e40 := d40 + d56
+	e41 := d41 + d57
+	e42 := d42 + d58
+	e43 := d43 + d59
+	e44 := d44 + d60
+	e45 := d45 + d61
+	e46 := d46 + d62
+	e47 := d47 + d63
+	e48 := d32 - d48
+	e49 := d33 - d49
+	e50 := d34 - d50
+	e51 := d35 - d51
+	e52 := d36 - d52
+	e53 := d37 - d53
+	e54 := d38 - d54
+	e55 := d39 - d55
+	e56 := d40 - d56
+	e57 := d41 - d57
+	e58 := d42 - d58
+	e59 := d43 - d59
+	e60 := d44 - d60
+	e61 := d45 - d61
+	e62 := d46 - d62
+	e63 := d47 - d63
+
+	return [64]T{
+		(e0 + e32) >> 6,
+		(e1 + e33) >> 6,
+		(e2 + e34) >> 6,
+		(e3 + e35) >> 6,
+		(e4 + e36) >> 6,
+		(e5 + e37) >> 6,
+		(e6 + e38) >> 6,
+		(e7 + e39) >> 6,
+		(e8 + e40) >> 6,
+		(e9 + e41) >> 6,
+		(e10 + e42) >> 6,
+		(e11 + e43) >> 6,
+		(e12 + e44) >> 6,
+		(e13 + e45) >> 6,
+		(e14 + e46) >> 6,
+		(e15 + e47) >> 6,
+		(e16 + e48) >> 6,
+		(e17 + e49) >> 6,
+		(e18 + e50) >> 6,
+		(e19 + e51) >> 6,
+		(e20 + e52) >> 6,
+		(e21 + e53) >> 6,
+		(e22 + e54) >> 6,
+		(e23 + e55) >> 6,
+		(e24 + e56) >> 6,
+		(e25 + e57) >> 6,
+		(e26 + e58) >> 6,
+		(e27 + e59) >> 6,
+		(e28 + e60) >> 6,
+		(e29 + e61) >> 6,
+		(e30 + e62) >> 6,
+		(e31 + e63) >> 6,
+		(e0 - e32) >> 6,
+		(e1 - e33) >> 6,
+		(e2 - e34) >> 6,
+		(e3 - e35) >> 6,
+		(e4 - e36) >> 6,
+		(e5 - e37) >> 6,
+		(e6 - e38) >> 6,
+		(e7 - e39) >> 6,
+		(e8 - e40) >> 6,
+		(e9 - e41) >> 6,
+		(e10 - e42) >> 6,
+		(e11 - e43) >> 6,
+		(e12 - e44) >> 6,
+		(e13 - e45) >> 6,
+		(e14 - e46) >> 6,
+		(e15 - e47) >> 6,
+		(e16 - e48) >> 6,
+		(e17 - e49) >> 6,
+		(e18 - e50) >> 6,
+		(e19 - e51) >> 6,
+		(e20 - e52) >> 6,
+		(e21 - e53) >> 6,
+		(e22 - e54) >> 6,
+		(e23 - e55) >> 6,
+		(e24 - e56) >> 6,
+		(e25 - e57) >> 6,
+		(e26 - e58) >> 6,
+		(e27 - e59) >> 6,
+		(e28 - e60) >> 6,
+		(e29 - e61) >> 6,
+		(e30 - e62) >> 6,
+		(e31 - e63) >> 6,
+	}
+}
+
+func transform4Dyadic[T SignedInt](in [4]T) [4]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+
+	return [4]T{
+		a0 + a2, // Dya 0 (Nat 0)
+		a0 - a2, // Dya 1 (Nat 2)
+		a1 + a3, // Dya 2 (Nat 1)
+		a1 - a3, // Dya 3 (Nat 3)
+	}
+}
+
+func transform8Dyadic[T SignedInt](in [8]T) [8]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+
+	return [8]T{
+		b0 + b4, // Dya 0 (Nat 0)
+		b0 - b4, // Dya 1 (Nat 4)
+		b2 + b6, // Dya 2 (Nat 2)
+		b2 - b6, // Dya 3 (Nat 6)
+		b1 + b5, // Dya 4 (Nat 1)
+		b1 - b5, // Dya 5 (Nat 5)
+		b3 + b7, // Dya 6 (Nat 3)
+		b3 - b7, // Dya 7 (Nat 7)
+	}
+}
+
+func transform16Dyadic[T SignedInt](in [16]T) [16]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+
+	return [16]T{
+		c0 + c8,  // Dya 0  (Nat 0)
+		c0 - c8,  // Dya 1  (Nat 8)
+		c4 + c12, // Dya 2  (Nat 4)
+		c4 - c12, // Dya 3  (Nat 12)
+		c2 + c10, // Dya 4  (Nat 2)
+		c2 - c10, // Dya 5  (Nat 10)
+		c6 + c14, // Dya 6  (Nat 6)
+		c6 - c14, // Dya 7  (Nat 14)
+		c1 + c9,  // Dya 8  (Nat 1)
+		c1 - c9,  // Dya 9  (Nat 9)
+		c5 + c13, // Dya 10 (Nat 5)
+		c5 - c13, // Dya 11 (Nat 13)
+		c3 + c11, // Dya 12 (Nat 3)
+		c3 - c11, // Dya 13 (Nat 11)
+		c7 + c15, // Dya 14 (Nat 7)
+		c7 - c15, // Dya 15 (Nat 15)
+	}
+}
+
+func transform32Dyadic[T SignedInt](in [32]T) [32]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+
+	return [32]T{
+		d0 + d16,  // Dya 0  (Nat 0)
+		d0 - d16,  // Dya 1  (Nat 16)
+		d8 + d24,  // Dya 2  (Nat 8)
+		d8 - d24,  // Dya 3  (Nat 24)
+		d4 + d20,  // Dya 4  (Nat 4)
+		d4 - d20,  // Dya 5  (Nat 20)
+		d12 + d28, // Dya 6  (Nat 12)
+		d12 - d28, // Dya 7  (Nat 28)
+		d2 + d18,  // Dya 8  (Nat 2)
+		d2 - d18,  // Dya 9  (Nat 18)
+		d10 + d26, // Dya 10 (Nat 10)
+		d10 - d26, // Dya 11 (Nat 26)
+		d6 + d22,  // Dya 12 (Nat 6)
+		d6 - d22,  // Dya 13 (Nat 22)
+		d14 + d30, // Dya 14 (Nat 14)
+		d14 - d30, // Dya 15 (Nat 30)
+		d1 + d17,  // Dya 16 (Nat 1)
+		d1 - d17,  // Dya 17 (Nat 17)
+		d9 + d25,  // Dya 18 (Nat 9)
+		d9 - d25,  // Dya 19 (Nat 25)
+		d5 + d21,  // Dya 20 (Nat 5)
+		d5 - d21,  // Dya 21 (Nat 21)
+		d13 + d29, // Dya 22 (Nat 13)
+		d13 - d29, // Dya 23 (Nat 29)
+		d3 + d19,  // Dya 24 (Nat 3)
+		d3 - d19,  // Dya 25 (Nat 19)
+		d11 + d27, // Dya 26 (Nat 11)
+		d11 - d27, // Dya 27 (Nat 27)
+		d7 + d23,  // Dya 28 (Nat 7)
+		d7 - d23,  // Dya 29 (Nat 23)
+		d15 + d31, // Dya 30 (Nat 15)
+		d15 - d31, // Dya 31 (Nat 31)
+	}
+}
+
+func transform64Dyadic[T SignedInt](in [64]T) [64]T {
+	a0 := in[0] + in[1]
+	a1 := in[0] - in[1]
+	a2 := in[2] + in[3]
+	a3 := in[2] - in[3]
+	a4 := in[4] + in[5]
+	a5 := in[4] - in[5]
+	a6 := in[6] + in[7]
+	a7 := in[6] - in[7]
+	a8 := in[8] + in[9]
+	a9 := in[8] - in[9]
+	a10 := in[10] + in[11]
+	a11 := in[10] - in[11]
+	a12 := in[12] + in[13]
+	a13 := in[12] - in[13]
+	a14 := in[14] + in[15]
+	a15 := in[14] - in[15]
+	a16 := in[16] + in[17]
+	a17 := in[16] - in[17]
+	a18 := in[18] + in[19]
+	a19 := in[18] - in[19]
+	a20 := in[20] + in[21]
+	a21 := in[20] - in[21]
+	a22 := in[22] + in[23]
+	a23 := in[22] - in[23]
+	a24 := in[24] + in[25]
+	a25 := in[24] - in[25]
+	a26 := in[26] + in[27]
+	a27 := in[26] - in[27]
+	a28 := in[28] + in[29]
+	a29 := in[28] - in[29]
+	a30 := in[30] + in[31]
+	a31 := in[30] - in[31]
+	a32 := in[32] + in[33]
+	a33 := in[32] - in[33]
+	a34 := in[34] + in[35]
+	a35 := in[34] - in[35]
+	a36 := in[36] + in[37]
+	a37 := in[36] - in[37]
+	a38 := in[38] + in[39]
+	a39 := in[38] - in[39]
+	a40 := in[40] + in[41]
+	a41 := in[40] - in[41]
+	a42 := in[42] + in[43]
+	a43 := in[42] - in[43]
+	a44 := in[44] + in[45]
+	a45 := in[44] - in[45]
+	a46 := in[46] + in[47]
+	a47 := in[46] - in[47]
+	a48 := in[48] + in[49]
+	a49 := in[48] - in[49]
+	a50 := in[50] + in[51]
+	a51 := in[50] - in[51]
+	a52 := in[52] + in[53]
+	a53 := in[52] - in[53]
+	a54 := in[54] + in[55]
+	a55 := in[54] - in[55]
+	a56 := in[56] + in[57]
+	a57 := in[56] - in[57]
+	a58 := in[58] + in[59]
+	a59 := in[58] - in[59]
+	a60 := in[60] + in[61]
+	a61 := in[60] - in[61]
+	a62 := in[62] + in[63]
+	a63 := in[62] - in[63]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+	b32 := a32 + a34
+	b33 := a33 + a35
+	b34 := a32 - a34
+	b35 := a33 - a35
+	b36 := a36 + a38
+	b37 := a37 + a39
+	b38 := a36 - a38
+	b39 := a37 - a39
+	b40 := a40 + a42
+	b41 := a41 + a43
+	b42 := a40 - a42
+	b43 := a41 - a43
+	b44 := a44 + a46
+	b45 := a45 + a47
+	b46 := a44 - a46
+	b47 := a45 - a47
+	b48 := a48 + a50
+	b49 := a49 + a51
+	b50 := a48 - a50
+	b51 := a49 - a51
+	b52 := a52 + a54
+	b53 := a53 + a55
+	b54 := a52 - a54
+	b55 := a53 - a55
+	b56 := a56 + a58
+	b57 := a57 + a59
+	b58 := a56 - a58
+	b59 := a57 - a59
+	b60 := a60 + a62
+	b61 := a61 + a63
+	b62 := a60 - a62
+	b63 := a61 - a63
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+	c32 := b32 + b36
+	c33 := b33 + b37
+	c34 := b34 + b38
+	c35 := b35 + b39
+	c36 := b32 - b36
+	c37 := b33 - b37
+	c38 := b34 - b38
+	c39 := b35 - b39
+	c40 := b40 + b44
+	c41 := b41 + b45
+	c42 := b42 + b46
+	c43 := b43 + b47
+	c44 := b40 - b44
+	c45 := b41 - b45
+	c46 := b42 - b46
+	c47 := b43 - b47
+	c48 := b48 + b52
+	c49 := b49 + b53
+	c50 := b50 + b54
+	c51 := b51 + b55
+	c52 := b48 - b52
+	c53 := b49 - b53
+	c54 := b50 - b54
+	c55 := b51 - b55
+	c56 := b56 + b60
+	c57 := b57 + b61
+	c58 := b58 + b62
+	c59 := b59 + b63
+	c60 := b56 - b60
+	c61 := b57 - b61
+	c62 := b58 - b62
+	c63 := b59 - b63
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+	d32 := c32 + c40
+	d33 := c33 + c41
+	d34 := c34 + c42
+	d35 := c35 + c43
+	d36 := c36 + c44
+	d37 := c37 + c45
+	d38 := c38 + c46
+	d39 := c39 + c47
+	d40 := c32 - c40
+	d41 := c33 - c41
+	d42 := c34 - c42
+	d43 := c35 - c43
+	d44 := c36 - c44
+	d45 := c37 - c45
+	d46 := c38 - c46
+	d47 := c39 - c47
+	d48 := c48 + c56
+	d49 := c49 + c57
+	d50 := c50 + c58
+	d51 := c51 + c59
+	d52 := c52 + c60
+	d53 := c53 + c61
+	d54 := c54 + c62
+	d55 := c55 + c63
+	d56 := c48 - c56
+	d57 := c49 - c57
+	d58 := c50 - c58
+	d59 := c51 - c59
+	d60 := c52 - c60
+	d61 := c53 - c61
+	d62 := c54 - c62
+	d63 := c55 - c63
+
+	e0 := d0 + d16
+	e1 := d1 + d17
+	e2 := d2 + d18
+	e3 := d3 + d19
+	e4 := d4 + d20
+	e5 := d5 + d21
+	e6 := d6 + d22
+	e7 := d7 + d23
+	e8 := d8 + d24
+	e9 := d9 + d25
+	e10 := d10 + d26
+	e11 := d11 + d27
+	e12 := d12 + d28
+	e13 := d13 + d29
+	e14 := d14 + d30
+	e15 := d15 + d31
+	e16 := d0 - d16
+	e17 := d1 - d17
+	e18 := d2 - d18
+	e19 := d3 - d19
+	e20 := d4 - d20
+	e21 := d5 - d21
+	e22 := d6 - d22
+	e23 := d7 - d23
+	e24 := d8 - d24
+	e25 := d9 - d25
+	e26 := d10 - d26
+	e27 := d11 - d27
+	e28 := d12 - d28
+	e29 := d13 - d29
+	e30 := d14 - d30
+	e31 := d15 - d31
+	e32 := d32 + d48
+	e33 := d33 + d49
+	e34 := d34 + d50
+	e35 := d35 + d51
+	e36 := d36 + d52
+	e37 := d37 + d53
+	e38 := d38 + d54
+	e39 := d39 + d55
+	e40 := d40 + d56
+	e41 := d41 + d57
+	e42 := d42 + d58
+	e43 := d43 + d59
+	e44 := d44 + d60
+	e45 := d45 + d61
+	e46 := d46 + d62
+	e47 := d47 + d63
+	e48 := d32 - d48
+	e49 := d33 - d49
+	e50 := d34 - d50
+	e51 := d35 - d51
+	e52 := d36 - d52
+	e53 := d37 - d53
+	e54 := d38 - d54
+	e55 := d39 - d55
+	e56 := d40 - d56
+	e57 := d41 - d57
+	e58 := d42 - d58
+	e59 := d43 - d59
+	e60 := d44 - d60
+	e61 := d45 - d61
+	e62 := d46 - d62
+	e63 := d47 - d63
+
+	return [64]T{
+		e0 + e32,  // Dya 0  (Nat 0)
+		e0 - e32,  // Dya 1  (Nat 32)
+		e16 + e48, // Dya 2  (Nat 16)
+		e16 - e48, // Dya 3  (Nat 48)
+		e8 + e40,  // Dya 4  (Nat 8)
+		e8 - e40,  // Dya 5  (Nat 40)
+		e24 + e56, // Dya 6  (Nat 24)
+		e24 - e56, // Dya 7  (Nat 56)
+		e4 + e36,  // Dya 8  (Nat 4)
+		e4 - e36,  // Dya 9  (Nat 36)
+		e20 + e52, // Dya 10 (Nat 20)
+		e20 - e52, // Dya 11 (Nat 52)
+		e12 + e44, // Dya 12 (Nat 12)
+		e12 - e44, // Dya 13 (Nat 44)
+		e28 + e60, // Dya 14 (Nat 28)
+		e28 - e60, // Dya 15 (Nat 60)
+		e2 + e34,  // Dya 16 (Nat 2)
+		e2 - e34,  // Dya 17 (Nat 34)
+		e18 + e50, // Dya 18 (Nat 18)
+		e18 - e50, // Dya 19 (Nat 50)
+		e10 + e42, // Dya 20 (Nat 10)
+		e10 - e42, // Dya 21 (Nat 42)
+		e26 + e58, // Dya 22 (Nat 26)
+		e26 - e58, // Dya 23 (Nat 58)
+		e6 + e38,  // Dya 24 (Nat 6)
+		e6 - e38,  // Dya 25 (Nat 38)
+		e22 + e54, // Dya 26 (Nat 22)
+		e22 - e54, // Dya 27 (Nat 54)
+		e14 + e46, // Dya 28 (Nat 14)
+		e14 - e46, // Dya 29 (Nat 46)
+		e30 + e62, // Dya 30 (Nat 30)
+		e30 - e62, // Dya 31 (Nat 62)
+		e1 + e33,  // Dya 32 (Nat 1)
+		e1 - e33,  // Dya 33 (Nat 33)
+		e17 + e49, // Dya 34 (Nat 17)
+		e17 - e49, // Dya 35 (Nat 49)
+		e9 + e41,  // Dya 36 (Nat 9)
+		e9 - e41,  // Dya 37 (Nat 41)
+		e25 + e57, // Dya 38 (Nat 25)
+		e25 - e57, // Dya 39 (Nat 57)
+		e5 + e37,  // Dya 40 (Nat 5)
+		e5 - e37,  // Dya 41 (Nat 37)
+		e21 + e53, // Dya 42 (Nat 21)
+		e21 - e53, // Dya 43 (Nat 53)
+		e13 + e45, // Dya 44 (Nat 13)
+		e13 - e45, // Dya 45 (Nat 45)
+		e29 + e61, // Dya 46 (Nat 29)
+		e29 - e61, // Dya 47 (Nat 61)
+		e3 + e35,  // Dya 48 (Nat 3)
+		e3 - e35,  // Dya 49 (Nat 35)
+		e19 + e51, // Dya 50 (Nat 19)
+		e19 - e51, // Dya 51 (Nat 51)
+		e11 + e43, // Dya 52 (Nat 11)
+		e11 - e43, // Dya 53 (Nat 43)
+		e27 + e59, // Dya 54 (Nat 27)
+		e27 - e59, // Dya 55 (Nat 59)
+		e7 + e39,  // Dya 56 (Nat 7)
+		e7 - e39,  // Dya 57 (Nat 39)
+		e23 + e55, // Dya 58 (Nat 23)
+		e23 - e55, // Dya 59 (Nat 55)
+		e15 + e47, // Dya 60 (Nat 15)
+		e15 - e47, // Dya 61 (Nat 47)
+		e31 + e63, // Dya 62 (Nat 31)
+		e31 - e63, // Dya 63 (Nat 63)
+	}
+}
+
+func invert4Dyadic[T SignedInt](in [4]T) [4]T {
+	a0 := in[0] + in[2] // in[0]=Nat0, in[2]=Nat1
+	a1 := in[0] - in[2]
+	a2 := in[1] + in[3] // in[1]=Nat2, in[3]=Nat3
+	a3 := in[1] - in[3]
+
+	return [4]T{
+		(a0 + a2) >> 2,
+		(a1 + a3) >> 2,
+		(a0 - a2) >> 2,
+		(a1 - a3) >> 2,
+	}
+}
+
+func invert8Dyadic[T SignedInt](in [8]T) [8]T {
+	a0 := in[0] + in[4] // in[0]=Nat0, in[4]=Nat1
+	a1 := in[0] - in[4]
+	a2 := in[2] + in[6] // in[2]=Nat2, in[6]=Nat3
+	a3 := in[2] - in[6]
+	a4 := in[1] + in[5] // in[1]=Nat4, in[5]=Nat5
+	a5 := in[1] - in[5]
+	a6 := in[3] + in[7] // in[3]=Nat6, in[7]=Nat7
+	a7 := in[3] - in[7]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+
+	return [8]T{
+		(b0 + b4) >> 3,
+		(b1 + b5) >> 3,
+		(b2 + b6) >> 3,
+		(b3 + b7) >> 3,
+		(b0 - b4) >> 3,
+		(b1 - b5) >> 3,
+		(b2 - b6) >> 3,
+		(b3 - b7) >> 3,
+	}
+}
+
+func invert16Dyadic[T SignedInt](in [16]T) [16]T {
+	a0 := in[0] + in[8] // in[0]=Nat0, in[8]=Nat1
+	a1 := in[0] - in[8]
+	a2 := in[4] + in[12] // in[4]=Nat2, in[12]=Nat3
+	a3 := in[4] - in[12]
+	a4 := in[2] + in[10] // in[2]=Nat4, in[10]=Nat5
+	a5 := in[2] - in[10]
+	a6 := in[6] + in[14] // in[6]=Nat6, in[14]=Nat7
+	a7 := in[6] - in[14]
+	a8 := in[1] + in[9] // in[1]=Nat8, in[9]=Nat9
+	a9 := in[1] - in[9]
+	a10 := in[5] + in[13] // in[5]=Nat10, in[13]=Nat11
+	a11 := in[5] - in[13]
+	a12 := in[3] + in[11] // in[3]=Nat12, in[11]=Nat13
+	a13 := in[3] - in[11]
+	a14 := in[7] + in[15] // in[7]=Nat14, in[15]=Nat15
+	a15 := in[7] - in[15]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+
+	return [16]T{
+		(c0 + c8) >> 4,
+		(c1 + c9) >> 4,
+		(c2 + c10) >> 4,
+		(c3 + c11) >> 4,
+		(c4 + c12) >> 4,
+		(c5 + c13) >> 4,
+		(c6 + c14) >> 4,
+		(c7 + c15) >> 4,
+		(c0 - c8) >> 4,
+		(c1 - c9) >> 4,
+		(c2 - c10) >> 4,
+		(c3 - c11) >> 4,
+		(c4 - c12) >> 4,
+		(c5 - c13) >> 4,
+		(c6 - c14) >> 4,
+		(c7 - c15) >> 4,
+	}
+}
+
+func invert32Dyadic[T SignedInt](in [32]T) [32]T {
+	a0 := in[0] + in[16] // in[0]=Nat0, in[16]=Nat1
+	a1 := in[0] - in[16]
+	a2 := in[8] + in[24] // in[8]=Nat2, in[24]=Nat3
+	a3 := in[8] - in[24]
+	a4 := in[4] + in[20] // in[4]=Nat4, in[20]=Nat5
+	a5 := in[4] - in[20]
+	a6 := in[12] + in[28] // in[12]=Nat6, in[28]=Nat7
+	a7 := in[12] - in[28]
+	a8 := in[2] + in[18] // in[2]=Nat8, in[18]=Nat9
+	a9 := in[2] - in[18]
+	a10 := in[10] + in[26] // in[10]=Nat10, in[26]=Nat11
+	a11 := in[10] - in[26]
+	a12 := in[6] + in[22] // in[6]=Nat12, in[22]=Nat13
+	a13 := in[6] - in[22]
+	a14 := in[14] + in[30] // in[14]=Nat14, in[30]=Nat15
+	a15 := in[14] - in[30]
+	a16 := in[1] + in[17] // in[1]=Nat16, in[17]=Nat17
+	a17 := in[1] - in[17]
+	a18 := in[9] + in[25] // in[9]=Nat18, in[25]=Nat19
+	a19 := in[9] - in[25]
+	a20 := in[5] + in[21] // in[5]=Nat20, in[21]=Nat21
+	a21 := in[5] - in[21]
+	a22 := in[13] + in[29] // in[13]=Nat22, in[29]=Nat23
+	a23 := in[13] - in[29]
+	a24 := in[3] + in[19] // in[3]=Nat24, in[19]=Nat25
+	a25 := in[3] - in[19]
+	a26 := in[11] + in[27] // in[11]=Nat26, in[27]=Nat27
+	a27 := in[11] - in[27]
+	a28 := in[7] + in[23] // in[7]=Nat28, in[23]=Nat29
+	a29 := in[7] - in[23]
+	a30 := in[15] + in[31] // in[15]=Nat30, in[31]=Nat31
+	a31 := in[15] - in[31]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+
+	return [32]T{
+		(d0 + d16) >> 5,
+		(d1 + d17) >> 5,
+		(d2 + d18) >> 5,
+		(d3 + d19) >> 5,
+		(d4 + d20) >> 5,
+		(d5 + d21) >> 5,
+		(d6 + d22) >> 5,
+		(d7 + d23) >> 5,
+		(d8 + d24) >> 5,
+		(d9 + d25) >> 5,
+		(d10 + d26) >> 5,
+		(d11 + d27) >> 5,
+		(d12 + d28) >> 5,
+		(d13 + d29) >> 5,
+		(d14 + d30) >> 5,
+		(d15 + d31) >> 5,
+		(d0 - d16) >> 5,
+		(d1 - d17) >> 5,
+		(d2 - d18) >> 5,
+		(d3 - d19) >> 5,
+		(d4 - d20) >> 5,
+		(d5 - d21) >> 5,
+		(d6 - d22) >> 5,
+		(d7 - d23) >> 5,
+		(d8 - d24) >> 5,
+		(d9 - d25) >> 5,
+		(d10 - d26) >> 5,
+		(d11 - d27) >> 5,
+		(d12 - d28) >> 5,
+		(d13 - d29) >> 5,
+		(d14 - d30) >> 5,
+		(d15 - d31) >> 5,
+	}
+}
+
+func invert64Dyadic[T SignedInt](in [64]T) [64]T {
+	a0 := in[0] + in[32] // in[0]=Nat0, in[32]=Nat1
+	a1 := in[0] - in[32]
+	a2 := in[16] + in[48] // in[16]=Nat2, in[48]=Nat3
+	a3 := in[16] - in[48]
+	a4 := in[8] + in[40] // in[8]=Nat4, in[40]=Nat5
+	a5 := in[8] - in[40]
+	a6 := in[24] + in[56] // in[24]=Nat6, in[56]=Nat7
+	a7 := in[24] - in[56]
+	a8 := in[4] + in[36] // in[4]=Nat8, in[36]=Nat9
+	a9 := in[4] - in[36]
+	a10 := in[20] + in[52] // in[20]=Nat10, in[52]=Nat11
+	a11 := in[20] - in[52]
+	a12 := in[12] + in[44] // in[12]=Nat12, in[44]=Nat13
+	a13 := in[12] - in[44]
+	a14 := in[28] + in[60] // in[28]=Nat14, in[60]=Nat15
+	a15 := in[28] - in[60]
+	a16 := in[2] + in[34] // in[2]=Nat16, in[34]=Nat17
+	a17 := in[2] - in[34]
+	a18 := in[18] + in[50] // in[18]=Nat18, in[50]=Nat19
+	a19 := in[18] - in[50]
+	a20 := in[10] + in[42] // in[10]=Nat20, in[42]=Nat21
+	a21 := in[10] - in[42]
+	a22 := in[26] + in[58] // in[26]=Nat22, in[58]=Nat23
+	a23 := in[26] - in[58]
+	a24 := in[6] + in[38] // in[6]=Nat24, in[38]=Nat25
+	a25 := in[6] - in[38]
+	a26 := in[22] + in[54] // in[22]=Nat26, in[54]=Nat27
+	a27 := in[22] - in[54]
+	a28 := in[14] + in[46] // in[14]=Nat28, in[46]=Nat29
+	a29 := in[14] - in[46]
+	a30 := in[30] + in[62] // in[30]=Nat30, in[62]=Nat31
+	a31 := in[30] - in[62]
+	a32 := in[1] + in[33] // in[1]=Nat32, in[33]=Nat33
+	a33 := in[1] - in[33]
+	a34 := in[17] + in[49] // in[17]=Nat34, in[49]=Nat35
+	a35 := in[17] - in[49]
+	a36 := in[9] + in[41] // in[9]=Nat36, in[41]=Nat37
+	a37 := in[9] - in[41]
+	a38 := in[25] + in[57] // in[25]=Nat38, in[57]=Nat39
+	a39 := in[25] - in[57]
+	a40 := in[5] + in[37] // in[5]=Nat40, in[37]=Nat41
+	a41 := in[5] - in[37]
+	a42 := in[21] + in[53] // in[21]=Nat42, in[53]=Nat43
+	a43 := in[21] - in[53]
+	a44 := in[13] + in[45] // in[13]=Nat44, in[45]=Nat45
+	a45 := in[13] - in[45]
+	a46 := in[29] + in[61] // in[29]=Nat46, in[61]=Nat47
+	a47 := in[29] - in[61]
+	a48 := in[3] + in[35] // in[3]=Nat48, in[35]=Nat49
+	a49 := in[3] - in[35]
+	a50 := in[19] + in[51] // in[19]=Nat50, in[51]=Nat51
+	a51 := in[19] - in[51]
+	a52 := in[11] + in[43] // in[11]=Nat52, in[43]=Nat53
+	a53 := in[11] - in[43]
+	a54 := in[27] + in[59] // in[27]=Nat54, in[59]=Nat55
+	a55 := in[27] - in[59]
+	a56 := in[7] + in[39] // in[7]=Nat56, in[39]=Nat57
+	a57 := in[7] - in[39]
+	a58 := in[23] + in[55] // in[23]=Nat58, in[55]=Nat59
+	a59 := in[23] - in[55]
+	a60 := in[15] + in[47] // in[15]=Nat60, in[47]=Nat61
+	a61 := in[15] - in[47]
+	a62 := in[31] + in[63] // in[31]=Nat62, in[63]=Nat63
+	a63 := in[31] - in[63]
+
+	b0 := a0 + a2
+	b1 := a1 + a3
+	b2 := a0 - a2
+	b3 := a1 - a3
+	b4 := a4 + a6
+	b5 := a5 + a7
+	b6 := a4 - a6
+	b7 := a5 - a7
+	b8 := a8 + a10
+	b9 := a9 + a11
+	b10 := a8 - a10
+	b11 := a9 - a11
+	b12 := a12 + a14
+	b13 := a13 + a15
+	b14 := a12 - a14
+	b15 := a13 - a15
+	b16 := a16 + a18
+	b17 := a17 + a19
+	b18 := a16 - a18
+	b19 := a17 - a19
+	b20 := a20 + a22
+	b21 := a21 + a23
+	b22 := a20 - a22
+	b23 := a21 - a23
+	b24 := a24 + a26
+	b25 := a25 + a27
+	b26 := a24 - a26
+	b27 := a25 - a27
+	b28 := a28 + a30
+	b29 := a29 + a31
+	b30 := a28 - a30
+	b31 := a29 - a31
+	b32 := a32 + a34
+	b33 := a33 + a35
+	b34 := a32 - a34
+	b35 := a33 - a35
+	b36 := a36 + a38
+	b37 := a37 + a39
+	b38 := a36 - a38
+	b39 := a37 - a39
+	b40 := a40 + a42
+	b41 := a41 + a43
+	b42 := a40 - a42
+	b43 := a41 - a43
+	b44 := a44 + a46
+	b45 := a45 + a47
+	b46 := a44 - a46
+	b47 := a45 - a47
+	b48 := a48 + a50
+	b49 := a49 + a51
+	b50 := a48 - a50
+	b51 := a49 - a51
+	b52 := a52 + a54
+	b53 := a53 + a55
+	b54 := a52 - a54
+	b55 := a53 - a55
+	b56 := a56 + a58
+	b57 := a57 + a59
+	b58 := a56 - a58
+	b59 := a57 - a59
+	b60 := a60 + a62
+	b61 := a61 + a63
+	b62 := a60 - a62
+	b63 := a61 - a63
+
+	c0 := b0 + b4
+	c1 := b1 + b5
+	c2 := b2 + b6
+	c3 := b3 + b7
+	c4 := b0 - b4
+	c5 := b1 - b5
+	c6 := b2 - b6
+	c7 := b3 - b7
+	c8 := b8 + b12
+	c9 := b9 + b13
+	c10 := b10 + b14
+	c11 := b11 + b15
+	c12 := b8 - b12
+	c13 := b9 - b13
+	c14 := b10 - b14
+	c15 := b11 - b15
+	c16 := b16 + b20
+	c17 := b17 + b21
+	c18 := b18 + b22
+	c19 := b19 + b23
+	c20 := b16 - b20
+	c21 := b17 - b21
+	c22 := b18 - b22
+	c23 := b19 - b23
+	c24 := b24 + b28
+	c25 := b25 + b29
+	c26 := b26 + b30
+	c27 := b27 + b31
+	c28 := b24 - b28
+	c29 := b25 - b29
+	c30 := b26 - b30
+	c31 := b27 - b31
+	c32 := b32 + b36
+	c33 := b33 + b37
+	c34 := b34 + b38
+	c35 := b35 + b39
+	c36 := b32 - b36
+	c37 := b33 - b37
+	c38 := b34 - b38
+	c39 := b35 - b39
+	c40 := b40 + b44
+	c41 := b41 + b45
+	c42 := b42 + b46
+	c43 := b43 + b47
+	c44 := b40 - b44
+	c45 := b41 - b45
+	c46 := b42 - b46
+	c47 := b43 - b47
+	c48 := b48 + b52
+	c49 := b49 + b53
+	c50 := b50 + b54
+	c51 := b51 + b55
+	c52 := b48 - b52
+	c53 := b49 - b53
+	c54 := b50 - b54
+	c55 := b51 - b55
+	c56 := b56 + b60
+	c57 := b57 + b61
+	c58 := b58 + b62
+	c59 := b59 + b63
+	c60 := b56 - b60
+	c61 := b57 - b61
+	c62 := b58 - b62
+	c63 := b59 - b63
+
+	d0 := c0 + c8
+	d1 := c1 + c9
+	d2 := c2 + c10
+	d3 := c3 + c11
+	d4 := c4 + c12
+	d5 := c5 + c13
+	d6 := c6 + c14
+	d7 := c7 + c15
+	d8 := c0 - c8
+	d9 := c1 - c9
+	d10 := c2 - c10
+	d11 := c3 - c11
+	d12 := c4 - c12
+	d13 := c5 - c13
+	d14 := c6 - c14
+	d15 := c7 - c15
+	d16 := c16 + c24
+	d17 := c17 + c25
+	d18 := c18 + c26
+	d19 := c19 + c27
+	d20 := c20 + c28
+	d21 := c21 + c29
+	d22 := c22 + c30
+	d23 := c23 + c31
+	d24 := c16 - c24
+	d25 := c17 - c25
+	d26 := c18 - c26
+	d27 := c19 - c27
+	d28 := c20 - c28
+	d29 := c21 - c29
+	d30 := c22 - c30
+	d31 := c23 - c31
+	d32 := c32 + c40
+	d33 := c33 + c41
+	d34 := c34 + c42
+	d35 := c35 + c43
+	d36 := c36 + c44
+	d37 := c37 + c45
+	d38 := c38 + c46
+	d39 := c39 + c47
+	d40 := c32 - c40
+	d41 := c33 - c41
+	d42 := c34 - c42
+	d43 := c35 - c43
+	d44 := c36 - c44
+	d45 := c37 - c45
+	d46 := c38 - c46
+	d47 := c39 - c47
+	d48 := c48 + c56
+	d49 := c49 + c57
+	d50 := c50 + c58
+	d51 := c51 + c59
+	d52 := c52 + c60
+	d53 := c53 + c61
+	d54 := c54 + c62
+	d55 := c55 + c63
+	d56 := c48 - c56
+	d57 := c49 - c57
+	d58 := c50 - c58
+	d59 := c51 - c59
+	d60 := c52 - c60
+	d61 := c53 - c61
+	d62 := c54 - c62
+	d63 := c55 - c63
+
+	e0 := d0 + d16
+	e1 := d1 + d17
+	e2 := d2 + d18
+	e3 := d3 + d19
+	e4 := d4 + d20
+	e5 := d5 + d21
+	e6 := d6 + d22
+	e7 := d7 + d23
+	e8 := d8 + d24
+	e9 := d9 + d25
+	e10 := d10 + d26
+	e11 := d11 + d27
+	e12 := d12 + d28
+	e13 := d13 + d29
+	e14 := d14 + d30
+	e15 := d15 + d31
+	e16 := d0 - d16
+	e17 := d1 - d17
+	e18 := d2 - d18
+	e19 := d3 - d19
+	e20 := d4 - d20
+	e21 := d5 - d21
+	e22 := d6 - d22
+	e23 := d7 - d23
+	e24 := d8 - d24
+	e25 := d9 - d25
+	e26 := d10 - d26
+	e27 := d11 - d27
+	e28 := d12 - d28
+	e29 := d13 - d29
+	e30 := d14 - d30
+	e31 := d15 - d31
+	e32 := d32 + d48
+	e33 := d33 + d49
+	e34 := d34 + d50
+	e35 := d35 + d51
+	e36 := d36 + d52
+	e37 := d37 + d53
+	e38 := d38 + d54
+	e39 := d39 + d55
+	e40 := d40 + d56
+	e41 := d41 + d57
+	e42 := d42 + d58
+	e43 := d43 + d59
+	e44 := d44 + d60
+	e45 := d45 + d61
+	e46 := d46 + d62
+	e47 := d47 + d63
+	e48 := d32 - d48
+	e49 := d33 - d49
+	e50 := d34 - d50
+	e51 := d35 - d51
+	e52 := d36 - d52
+	e53 := d37 - d53
+	e54 := d38 - d54
+	e55 := d39 - d55
+	e56 := d40 - d56
+	e57 := d41 - d57
+	e58 := d42 - d58
+	e59 := d43 - d59
+	e60 := d44 - d60
+	e61 := d45 - d61
+	e62 := d46 - d62
+	e63 := d47 - d63
+
+	return [64]T{
+		(e0 + e32) >> 6,
+		(e1 + e33) >> 6,
+		(e2 + e34) >> 6,
+		(e3 + e35) >> 6,
+		(e4 + e36) >> 6,
+		(e5 + e37) >> 6,
+		(e6 + e38) >> 6,
+		(e7 + e39) >> 6,
+		(e8 + e40) >> 6,
+		(e9 + e41) >> 6,
+		(e10 + e42) >> 6,
+		(e11 + e43) >> 6,
+		(e12 + e44) >> 6,
+		(e13 + e45) >> 6,
+		(e14 + e46) >> 6,
+		(e15 + e47) >> 6,
+		(e16 + e48) >> 6,
+		(e17 + e49) >> 6,
+		(e18 + e50) >> 6,
+		(e19 + e51) >> 6,
+		(e20 + e52) >> 6,
+		(e21 + e53) >> 6,
+		(e22 + e54) >> 6,
+		(e23 + e55) >> 6,
+		(e24 + e56) >> 6,
+		(e25 + e57) >> 6,
+		(e26 + e58) >> 6,
+		(e27 + e59) >> 6,
+		(e28 + e60) >> 6,
+		(e29 + e61) >> 6,
+		(e30 + e62) >> 6,
+		(e31 + e63) >> 6,
+		(e0 - e32) >> 6,
+		(e1 - e33) >> 6,
+		(e2 - e34) >> 6,
+		(e3 - e35) >> 6,
+		(e4 - e36) >> 6,
+		(e5 - e37) >> 6,
+		(e6 - e38) >> 6,
+		(e7 - e39) >> 6,
+		(e8 - e40) >> 6,
+		(e9 - e41) >> 6,
+		(e10 - e42) >> 6,
+		(e11 - e43) >> 6,
+		(e12 - e44) >> 6,
+		(e13 - e45) >> 6,
+		(e14 - e46) >> 6,
+		(e15 - e47) >> 6,
+		(e16 - e48) >> 6,
+		(e17 - e49) >> 6,
+		(e18 - e50) >> 6,
+		(e19 - e51) >> 6,
+		(e20 - e52) >> 6,
+		(e21 - e53) >> 6,
+		(e22 - e54) >> 6,
+		(e23 - e55) >> 6,
+		(e24 - e56) >> 6,
+		(e25 - e57) >> 6,
+		(e26 - e58) >> 6,
+		(e27 - e59) >> 6,
+		(e28 - e60) >> 6,
+		(e29 - e61) >> 6,
+		(e30 - e62) >> 6,
+		(e31 - e63) >> 6,
+	}
+}
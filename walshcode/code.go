@@ -0,0 +1,94 @@
+// Package walshcode implements Walsh and OVSF spreading codes for CDMA channelisation,
+// despreading every channel at once by the fast Walsh-Hadamard Transform.
+package walshcode
+
+import (
+	"errors"
+
+	"github.com/octu0/wht"
+)
+
+var (
+	ErrNotPowerOfTwo     = errors.New("walshcode: spreading factor must be power of 2")
+	ErrOutOfRange        = errors.New("walshcode: code index out of range")
+	ErrDuplicateChannel  = errors.New("walshcode: channel assigned twice")
+	ErrLengthMismatch    = errors.New("walshcode: length mismatch")
+	ErrNoChannel         = errors.New("walshcode: no channel")
+	ErrInvalidSymbolSize = errors.New("walshcode: chips are not a multiple of spreading factor")
+)
+
+// Code returns Walsh code k of spreading factor sf as +1/-1 chips; code k changes sign exactly k times.
+func Code(sf, k int) ([]int8, error) {
+	if isPowerOfTwo(sf) != true {
+		return nil, ErrNotPowerOfTwo
+	}
+	if k < 0 || sf <= k {
+		return nil, ErrOutOfRange
+	}
+	return wht.WalshFunction[int8](k, sf, wht.OrderSequency)
+}
+
+// Codes returns the sf mutually orthogonal Walsh codes of spreading factor sf in Sequency Order.
+func Codes(sf int) ([][]int8, error) {
+	if isPowerOfTwo(sf) != true {
+		return nil, ErrNotPowerOfTwo
+	}
+
+	codes := make([][]int8, sf)
+	for k := range codes {
+		c, err := Code(sf, k)
+		if err != nil {
+			return nil, err
+		}
+		codes[k] = c
+	}
+	return codes, nil
+}
+
+// OVSF returns the orthogonal variable spreading factor code C(sf, k) of the code tree,
+// where C(2n, 2k) = [C(n, k), C(n, k)] and C(2n, 2k+1) = [C(n, k), -C(n, k)] from C(1, 0) = [1].
+// Codes of different spreading factors are orthogonal unless one is an ancestor of the other in the tree.
+// The tree index k is the Dyadic Order index of the Walsh function.
+func OVSF(sf, k int) ([]int8, error) {
+	if isPowerOfTwo(sf) != true {
+		return nil, ErrNotPowerOfTwo
+	}
+	if k < 0 || sf <= k {
+		return nil, ErrOutOfRange
+	}
+
+	code := make([]int8, sf)
+	code[0] = 1
+	// walk down from the root, the most significant bit of k selects the first branch
+	for n := 1; n < sf; n <<= 1 {
+		neg := k&(sf/(2*n)) != 0
+		for i := 0; i < n; i += 1 {
+			if neg {
+				code[n+i] = -code[i]
+			} else {
+				code[n+i] = code[i]
+			}
+		}
+	}
+	return code, nil
+}
+
+// OVSFToSequency returns the Sequency Order index of OVSF code C(sf, k), the channel of it in a Spreader.
+func OVSFToSequency(sf, k int) (int, error) {
+	if isPowerOfTwo(sf) != true {
+		return 0, ErrNotPowerOfTwo
+	}
+	if k < 0 || sf <= k {
+		return 0, ErrOutOfRange
+	}
+
+	bitsLen := 0
+	for 1<<bitsLen < sf {
+		bitsLen += 1
+	}
+	return wht.OrderIndex(wht.OrderSequency, wht.NaturalIndex(wht.OrderDyadic, k, bitsLen), bitsLen), nil
+}
+
+func isPowerOfTwo(n int) bool {
+	return 0 < n && n&(n-1) == 0
+}
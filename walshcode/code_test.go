@@ -0,0 +1,120 @@
+package walshcode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/octu0/wht"
+)
+
+func correlate(a, b []int8) int {
+	sum := 0
+	for i := range a {
+		sum += int(a[i]) * int(b[i])
+	}
+	return sum
+}
+
+func TestCodes(t *testing.T) {
+	for _, sf := range []int{1, 2, 8, 64} {
+		codes, err := Codes(sf)
+		if err != nil {
+			t.Fatalf("no error: %+v", err)
+		}
+		for k, code := range codes {
+			changes := 0
+			for c := 1; c < sf; c += 1 {
+				if code[c] != code[c-1] {
+					changes += 1
+				}
+			}
+			if changes != k {
+				t.Errorf("sf=%d: code %d changes sign %d times", sf, k, changes)
+			}
+			for j, other := range codes {
+				expect := 0
+				if j == k {
+					expect = sf
+				}
+				if v := correlate(code, other); v != expect {
+					t.Errorf("sf=%d: <%d,%d> = %d != %d", sf, k, j, v, expect)
+				}
+			}
+		}
+	}
+	if _, err := Codes(6); errors.Is(err, ErrNotPowerOfTwo) != true {
+		t.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+	}
+	if _, err := Code(8, 8); errors.Is(err, ErrOutOfRange) != true {
+		t.Errorf("expect ErrOutOfRange: %+v", err)
+	}
+}
+
+func TestOVSF(t *testing.T) {
+	t.Run("tree", func(tt *testing.T) {
+		tree := [][]int8{{1}}
+		for sf := 2; sf <= 32; sf <<= 1 {
+			next := make([][]int8, 0, sf)
+			for _, c := range tree {
+				neg := make([]int8, len(c))
+				for i, v := range c {
+					neg[i] = -v
+				}
+				next = append(next, append(append([]int8(nil), c...), c...))
+				next = append(next, append(append([]int8(nil), c...), neg...))
+			}
+			tree = next
+
+			for k, expect := range tree {
+				code, err := OVSF(sf, k)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				if cmp.Equal(code, expect) != true {
+					tt.Errorf("C(%d,%d): %v != %v", sf, k, code, expect)
+				}
+				dyadic, _ := wht.WalshFunction[int8](k, sf, wht.OrderDyadic)
+				if cmp.Equal(code, dyadic) != true {
+					tt.Errorf("C(%d,%d): %v != %v", sf, k, code, dyadic)
+				}
+
+				seq, err := OVSFToSequency(sf, k)
+				if err != nil {
+					tt.Fatalf("no error: %+v", err)
+				}
+				walsh, _ := Code(sf, seq)
+				if cmp.Equal(code, walsh) != true {
+					tt.Errorf("C(%d,%d): %v != %v", sf, k, code, walsh)
+				}
+			}
+		}
+	})
+	t.Run("variable", func(tt *testing.T) {
+		// C(4,1) sends one symbol per 4 chips; it is orthogonal over each symbol to every code of SF 8
+		// except its children C(8,2) and C(8,3)
+		parent, _ := OVSF(4, 1)
+		for k := 0; k < 8; k += 1 {
+			child, _ := OVSF(8, k)
+			first, second := correlate(parent, child[:4]), correlate(parent, child[4:])
+			if k == 2 || k == 3 {
+				if first == 0 || second == 0 {
+					tt.Errorf("C(8,%d) is a child of C(4,1)", k)
+				}
+			} else if first != 0 || second != 0 {
+				tt.Errorf("<C(4,1), C(8,%d)> = %d, %d", k, first, second)
+			}
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := OVSF(12, 0); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if _, err := OVSF(8, -1); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := OVSFToSequency(8, 8); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package walshcode
+
+import (
+	"github.com/octu0/wht"
+)
+
+// Spreader multiplexes the symbol streams of several users onto one chip stream,
+// each user on its own Walsh code of spreading factor sf.
+// A Spreader is not safe for concurrent use.
+type Spreader[T wht.Float] struct {
+	sf       int
+	channels []int
+	temp     []T
+}
+
+// NewSpreader creates a Spreader where user u transmits on Walsh code channels[u] (Sequency Order).
+func NewSpreader[T wht.Float](sf int, channels []int) (*Spreader[T], error) {
+	if isPowerOfTwo(sf) != true {
+		return nil, ErrNotPowerOfTwo
+	}
+	if len(channels) < 1 {
+		return nil, ErrNoChannel
+	}
+	used := make([]bool, sf)
+	for _, k := range channels {
+		if k < 0 || sf <= k {
+			return nil, ErrOutOfRange
+		}
+		if used[k] {
+			return nil, ErrDuplicateChannel
+		}
+		used[k] = true
+	}
+
+	return &Spreader[T]{
+		sf:       sf,
+		channels: append([]int(nil), channels...),
+		temp:     make([]T, sf),
+	}, nil
+}
+
+// SpreadingFactor returns the number of chips per symbol.
+func (s *Spreader[T]) SpreadingFactor() int {
+	return s.sf
+}
+
+// Channels returns the Walsh code of every user, which must not be modified.
+func (s *Spreader[T]) Channels() []int {
+	return s.channels
+}
+
+// Spread returns the sum of the spread streams, symbols[u] being the stream of user u.
+// Every stream must have the same length; the output has sf chips per symbol.
+func (s *Spreader[T]) Spread(symbols [][]T) ([]T, error) {
+	if len(symbols) != len(s.channels) {
+		return nil, ErrLengthMismatch
+	}
+	size := len(symbols[0])
+	for _, stream := range symbols {
+		if len(stream) != size {
+			return nil, ErrLengthMismatch
+		}
+	}
+
+	chips := make([]T, size*s.sf)
+	for i := 0; i < size; i += 1 {
+		// chips = H^T v, where v[k] is the symbol sent on code k
+		clear(s.temp)
+		for u, k := range s.channels {
+			s.temp[k] = symbols[u][i]
+		}
+		wht.InvertOrder(s.temp, wht.OrderSequency)
+		out := chips[i*s.sf : (i+1)*s.sf]
+		for c, v := range s.temp {
+			out[c] = v * T(s.sf)
+		}
+	}
+	return chips, nil
+}
+
+// DespreadAll returns the symbol stream of every code, out[k] being the correlation with Walsh code k
+// normalised by sf. One transform per symbol despreads all sf channels in O(sf log sf).
+func (s *Spreader[T]) DespreadAll(chips []T) ([][]T, error) {
+	if len(chips)%s.sf != 0 {
+		return nil, ErrInvalidSymbolSize
+	}
+
+	size := len(chips) / s.sf
+	out := make([][]T, s.sf)
+	for k := range out {
+		out[k] = make([]T, size)
+	}
+	for i := 0; i < size; i += 1 {
+		copy(s.temp, chips[i*s.sf:(i+1)*s.sf])
+		wht.TransformOrder(s.temp, wht.OrderSequency)
+		for k, v := range s.temp {
+			out[k][i] = v / T(s.sf)
+		}
+	}
+	return out, nil
+}
+
+// Despread returns the symbol stream of every user.
+func (s *Spreader[T]) Despread(chips []T) ([][]T, error) {
+	all, err := s.DespreadAll(chips)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([][]T, len(s.channels))
+	for u, k := range s.channels {
+		out[u] = all[k]
+	}
+	return out, nil
+}
+
+// DespreadChannel returns the symbol stream of Walsh code k by correlating with the code alone, O(sf) per symbol.
+func DespreadChannel[T wht.Float](chips []T, sf, k int) ([]T, error) {
+	code, err := Code(sf, k)
+	if err != nil {
+		return nil, err
+	}
+	if len(chips)%sf != 0 {
+		return nil, ErrInvalidSymbolSize
+	}
+
+	out := make([]T, len(chips)/sf)
+	for i := range out {
+		sum := T(0)
+		for c, v := range code {
+			sum += chips[i*sf+c] * T(v)
+		}
+		out[i] = sum / T(sf)
+	}
+	return out, nil
+}
@@ -0,0 +1,161 @@
+package walshcode
+
+import (
+	"errors"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func randomSymbols(r *rand.Rand, users, size int) [][]float64 {
+	symbols := make([][]float64, users)
+	for u := range symbols {
+		symbols[u] = make([]float64, size)
+		for i := range symbols[u] {
+			if r.Uint64()&1 == 0 {
+				symbols[u][i] = 1
+			} else {
+				symbols[u][i] = -1
+			}
+		}
+	}
+	return symbols
+}
+
+func TestSpreader(t *testing.T) {
+	t.Run("noiseless", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(1, 2))
+		channels := []int{0, 3, 5, 31}
+		s, err := NewSpreader[float64](32, channels)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		symbols := randomSymbols(r, len(channels), 20)
+		chips, err := s.Spread(symbols)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if len(chips) != 20*32 {
+			tt.Errorf("chips %d != %d", len(chips), 20*32)
+		}
+		got, err := s.Despread(chips)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(got, symbols, cmpopts.EquateApprox(0, 1e-12)) != true {
+			tt.Errorf("%v != %v", got, symbols)
+		}
+
+		// a single user's chips are its symbols times its code
+		code, _ := Code(32, 5)
+		single, _ := NewSpreader[float64](32, []int{5})
+		one, _ := single.Spread(symbols[2:3])
+		for i, v := range one {
+			if expect := symbols[2][i/32] * float64(code[i%32]); math.Abs(v-expect) > 1e-12 {
+				tt.Errorf("chip %d: %v != %v", i, v, expect)
+			}
+		}
+	})
+	t.Run("all", func(tt *testing.T) {
+		r := rand.New(rand.NewPCG(3, 4))
+		s, _ := NewSpreader[float64](16, []int{1, 2, 8})
+		chips, _ := s.Spread(randomSymbols(r, 3, 10))
+		for i := range chips {
+			chips[i] += 0.3 * r.NormFloat64()
+		}
+		all, err := s.DespreadAll(chips)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		for k := 0; k < 16; k += 1 {
+			expect, err := DespreadChannel(chips, 16, k)
+			if err != nil {
+				tt.Fatalf("no error: %+v", err)
+			}
+			if cmp.Equal(all[k], expect, cmpopts.EquateApprox(0, 1e-12)) != true {
+				tt.Errorf("code %d: %v != %v", k, all[k], expect)
+			}
+		}
+	})
+	t.Run("noisy", func(tt *testing.T) {
+		// despreading gains sf in SNR: chip noise sigma becomes sigma/sqrt(sf) per symbol
+		const sf, size, sigma = 64, 2000, 2.0
+		r := rand.New(rand.NewPCG(5, 6))
+		channels := []int{1, 7, 12, 33, 40, 63}
+		s, _ := NewSpreader[float64](sf, channels)
+		symbols := randomSymbols(r, len(channels), size)
+		chips, _ := s.Spread(symbols)
+		for i := range chips {
+			chips[i] += sigma * r.NormFloat64()
+		}
+		got, err := s.Despread(chips)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+
+		// symbol error rate Q(sqrt(sf)/sigma) = Q(4) = 3.2e-5
+		errs := 0
+		for u := range symbols {
+			for i, v := range got[u] {
+				if (v < 0) != (symbols[u][i] < 0) {
+					errs += 1
+				}
+			}
+		}
+		if errs > 3 {
+			tt.Errorf("%d symbol errors in %d", errs, len(channels)*size)
+		}
+
+		// unused codes carry noise only, with variance sigma^2/sf
+		all, _ := s.DespreadAll(chips)
+		power := 0.0
+		for _, v := range all[2] {
+			power += v * v
+		}
+		if p, expect := power/size, sigma*sigma/sf; math.Abs(p-expect) > 0.2*expect {
+			tt.Errorf("noise power %v expect %v", p, expect)
+		}
+	})
+	t.Run("float32", func(tt *testing.T) {
+		s, _ := NewSpreader[float32](8, []int{2, 6})
+		symbols := [][]float32{{1, -1, 1}, {-1, -1, 1}}
+		chips, err := s.Spread(symbols)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		got, _ := s.Despread(chips)
+		if cmp.Equal(got, symbols) != true {
+			tt.Errorf("%v != %v", got, symbols)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if _, err := NewSpreader[float64](10, []int{0}); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if _, err := NewSpreader[float64](8, nil); errors.Is(err, ErrNoChannel) != true {
+			tt.Errorf("expect ErrNoChannel: %+v", err)
+		}
+		if _, err := NewSpreader[float64](8, []int{1, 8}); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+		if _, err := NewSpreader[float64](8, []int{1, 1}); errors.Is(err, ErrDuplicateChannel) != true {
+			tt.Errorf("expect ErrDuplicateChannel: %+v", err)
+		}
+		s, _ := NewSpreader[float64](8, []int{1, 2})
+		if _, err := s.Spread([][]float64{{1}}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := s.Spread([][]float64{{1}, {1, 1}}); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := s.Despread(make([]float64, 12)); errors.Is(err, ErrInvalidSymbolSize) != true {
+			tt.Errorf("expect ErrInvalidSymbolSize: %+v", err)
+		}
+		if _, err := DespreadChannel(make([]float64, 8), 8, 9); errors.Is(err, ErrOutOfRange) != true {
+			tt.Errorf("expect ErrOutOfRange: %+v", err)
+		}
+	})
+}
@@ -1,8 +1,6 @@
 package wht
 
-import (
-	"math/bits"
-)
+//go:generate go run cmd/gen.go
 
 func Transform4[T SignedInt](in [4]T) [4]T {
 	return [4]T{
@@ -232,70 +230,52 @@ func Invert16[T SignedInt](in [16]T) [16]T {
 // Transform applies Walsh-Hadamard Transform to a slice of any size 2^n.
 // The output is reordered to Sequency Order.
 func Transform[T Signed](in []T) {
-	n := len(in)
-	if n < 1 {
-		return
-	}
-	if (n & (n - 1)) != 0 {
-		return
-	}
-
-	// 1. In-place Fast Walsh-Hadamard Transform (Natural Order)
-	fwht(in, n)
-
-	// 2. Permutation to Sequency Order
-	// The mapping from Sequency Order index 'k' to Natural Order index 'nat'
-	// is given by BitReverse(GrayCode(k)).
-	temp := make([]T, n)
-	bitsLen := bits.Len(uint(n)) - 1
-	for k := 0; k < n; k += 1 {
-		g := k ^ (k >> 1)
-		nat := bits.Reverse(uint(g)) >> (64 - bitsLen)
-		temp[k] = in[nat]
-	}
-	copy(in, temp)
+	TransformOrder(in, OrderSequency)
 }
 
 // Invert applies Inverse Walsh-Hadamard Transform to a slice of any size 2^n.
 // Assumes the input is in Sequency Order.
 func Invert[T Signed](in []T) {
-	n := len(in)
-	if n < 1 {
-		return
-	}
+	InvertOrder(in, OrderSequency)
+}
 
-	// 1. Permutation from Sequency Order back to Natural Order
-	temp := make([]T, n)
-	bitsLen := bits.Len(uint(n)) - 1
-	for k := 0; k < n; k += 1 {
-		g := k ^ (k >> 1)
-		nat := bits.Reverse(uint(g)) >> (64 - bitsLen)
-		temp[nat] = in[k]
+func fwhtGeneric[T Signed](in []T, n int) {
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				a := in[j]
+				b := in[j+half]
+				in[j] = a + b
+				in[j+half] = a - b
+			}
+		}
 	}
-	copy(in, temp)
-
-	// 2. Inverse FWHT (Natural Order)
-	fwht(in, n)
+}
 
-	// 3. Normalization
-	for i, v := range in {
-		in[i] = v / T(n)
+// TransformE is Transform that returns an error instead of ignoring invalid input.
+func TransformE[T Signed](in []T) error {
+	if err := checkLength(len(in)); err != nil {
+		return err
 	}
+	Transform(in)
+	return nil
 }
 
-func fwht[T Signed](in []T, n int) {
-	if n < 2 {
-		return
+// InvertE is Invert that returns an error instead of ignoring invalid input.
+func InvertE[T Signed](in []T) error {
+	if err := checkLength(len(in)); err != nil {
+		return err
 	}
-	half := n / 2
-
-	fwht(in[:half], half)
-	fwht(in[half:], half)
+	Invert(in)
+	return nil
+}
 
-	for i := 0; i < half; i += 1 {
-		a := in[i]
-		b := in[i+half]
-		in[i] = a + b
-		in[i+half] = a - b
+func checkLength(n int) error {
+	if n < 1 {
+		return ErrEmpty
+	}
+	if isPowerOfTwo(n) != true {
+		return ErrNotPowerOfTwo
 	}
+	return nil
 }
@@ -1,6 +1,8 @@
 package wht
 
 import (
+	"errors"
+	"math/rand/v2"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -34,6 +36,87 @@ func TestTransformInline(t *testing.T) {
 			tt.Errorf("%v != %v", x, y)
 		}
 	})
+	t.Run("32", func(tt *testing.T) {
+		x := [32]int16{}
+		for i := range x {
+			x[i] = int16((i * 29) % 97)
+		}
+		r := Transform32(x)
+		y := Invert32(r)
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+	t.Run("64", func(tt *testing.T) {
+		x := [64]int16{}
+		for i := range x {
+			x[i] = int16((i*53)%211 - 100)
+		}
+		r := Transform64(x)
+		y := Invert64(r)
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+}
+
+func TestTransformInlineGeneric(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	fill := func(x []int32) []int32 {
+		for i := range x {
+			x[i] = r.Int32N(1<<12) - (1 << 11)
+		}
+		return append([]int32(nil), x...)
+	}
+	check := func(tt *testing.T, x []int32, r, y []int32) {
+		expect := append([]int32(nil), x...)
+		Transform(expect)
+		if cmp.Equal(r, expect) != true {
+			tt.Errorf("transform %v != %v", r, expect)
+		}
+		Invert(expect)
+		if cmp.Equal(y, expect) != true {
+			tt.Errorf("invert %v != %v", y, expect)
+		}
+	}
+
+	for c := 0; c < 10; c += 1 {
+		t.Run("4", func(tt *testing.T) {
+			x := [4]int32{}
+			in := fill(x[:])
+			r := Transform4([4]int32(in))
+			y := Invert4(r)
+			check(tt, in, r[:], y[:])
+		})
+		t.Run("8", func(tt *testing.T) {
+			x := [8]int32{}
+			in := fill(x[:])
+			r := Transform8([8]int32(in))
+			y := Invert8(r)
+			check(tt, in, r[:], y[:])
+		})
+		t.Run("16", func(tt *testing.T) {
+			x := [16]int32{}
+			in := fill(x[:])
+			r := Transform16([16]int32(in))
+			y := Invert16(r)
+			check(tt, in, r[:], y[:])
+		})
+		t.Run("32", func(tt *testing.T) {
+			x := [32]int32{}
+			in := fill(x[:])
+			r := Transform32([32]int32(in))
+			y := Invert32(r)
+			check(tt, in, r[:], y[:])
+		})
+		t.Run("64", func(tt *testing.T) {
+			x := [64]int32{}
+			in := fill(x[:])
+			r := Transform64([64]int32(in))
+			y := Invert64(r)
+			check(tt, in, r[:], y[:])
+		})
+	}
 }
 
 func TestTransform(t *testing.T) {
@@ -108,3 +191,44 @@ func TestTransform(t *testing.T) {
 		}
 	})
 }
+
+func TestTransformE(t *testing.T) {
+	t.Run("valid", func(tt *testing.T) {
+		x := []int16{1, 0, 1, 0}
+		if err := TransformE(x); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int16{2, 0, 0, 2}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		if err := InvertE(x); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect2 := []int16{1, 0, 1, 0}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("empty", func(tt *testing.T) {
+		if err := TransformE([]int16{}); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+		if err := InvertE([]int16(nil)); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+	t.Run("not power of two", func(tt *testing.T) {
+		x := []int16{1, 2, 3, 4, 5, 6}
+		if err := TransformE(x); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		if err := InvertE(x); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+		expect := []int16{1, 2, 3, 4, 5, 6}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("input modified: %v != %v", x, expect)
+		}
+	})
+}
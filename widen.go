@@ -0,0 +1,128 @@
+package wht
+
+import (
+	"math/bits"
+)
+
+// Transform8To16 applies Walsh-Hadamard Transform to int8 samples and writes int16 coefficients to out.
+// int16 holds the coefficients of up to 256 samples without overflow, longer input returns ErrOverflow.
+func Transform8To16(in []int8, out []int16) error {
+	return transformWiden(in, out, 8)
+}
+
+// Transform16To32 applies Walsh-Hadamard Transform to int16 samples and writes int32 coefficients to out.
+// int32 holds the coefficients of up to 65536 samples without overflow, longer input returns ErrOverflow.
+func Transform16To32(in []int16, out []int32) error {
+	return transformWiden(in, out, 16)
+}
+
+// Transform32To64 applies Walsh-Hadamard Transform to int32 samples and writes int64 coefficients to out.
+// int64 holds the coefficients of up to 2^32 samples without overflow, longer input returns ErrOverflow.
+func Transform32To64(in []int32, out []int64) error {
+	return transformWiden(in, out, 32)
+}
+
+// Invert16To8 applies Inverse Walsh-Hadamard Transform to int16 coefficients produced by Transform8To16
+// and writes int8 samples to out. The coefficients in 'in' are used as working space.
+func Invert16To8(in []int16, out []int8) error {
+	return invertNarrow(in, out)
+}
+
+// Invert32To16 applies Inverse Walsh-Hadamard Transform to int32 coefficients produced by Transform16To32
+// and writes int16 samples to out. The coefficients in 'in' are used as working space.
+func Invert32To16(in []int32, out []int16) error {
+	return invertNarrow(in, out)
+}
+
+// Invert64To32 applies Inverse Walsh-Hadamard Transform to int64 coefficients produced by Transform32To64
+// and writes int32 samples to out. The coefficients in 'in' are used as working space.
+func Invert64To32(in []int64, out []int32) error {
+	return invertNarrow(in, out)
+}
+
+// TransformChecked is Transform that detects integer overflow in the butterflies.
+// It returns ErrOverflow when any intermediate value overflows T, the contents of 'in' are unspecified then.
+// It is slower than Transform and intended for tests that decide the narrowest safe storage.
+func TransformChecked[T SignedInt](in []T) error {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	if err := fwhtChecked(in, n); err != nil {
+		return err
+	}
+	Reorder(in, OrderNatural, OrderSequency)
+	return nil
+}
+
+// InvertChecked is Invert that detects integer overflow in the butterflies.
+func InvertChecked[T SignedInt](in []T) error {
+	n := len(in)
+	if err := checkLength(n); err != nil {
+		return err
+	}
+	Reorder(in, OrderSequency, OrderNatural)
+	if err := fwhtChecked(in, n); err != nil {
+		return err
+	}
+	for i, v := range in {
+		in[i] = v / T(n)
+	}
+	return nil
+}
+
+// transformWiden widens by extraBits, the coefficients of n samples grow by log2(n) bits.
+func transformWiden[In, Out SignedInt](in []In, out []Out, extraBits int) error {
+	if err := checkLength(len(in)); err != nil {
+		return err
+	}
+	if len(in) != len(out) {
+		return ErrLengthMismatch
+	}
+	if extraBits < bits.Len64(uint64(len(in)))-1 {
+		return ErrOverflow
+	}
+
+	for i, v := range in {
+		out[i] = Out(v)
+	}
+	Transform(out)
+	return nil
+}
+
+func invertNarrow[In, Out SignedInt](in []In, out []Out) error {
+	if err := checkLength(len(in)); err != nil {
+		return err
+	}
+	if len(in) != len(out) {
+		return ErrLengthMismatch
+	}
+
+	Invert(in)
+	for i, v := range in {
+		out[i] = Out(v)
+	}
+	return nil
+}
+
+func fwhtChecked[T SignedInt](in []T, n int) error {
+	for half := 1; half < n; half <<= 1 {
+		for i := 0; i < n; i += half << 1 {
+			for j := i; j < i+half; j += 1 {
+				a := in[j]
+				b := in[j+half]
+				sum := a + b
+				diff := a - b
+				if (0 <= a) == (0 <= b) && (0 <= sum) != (0 <= a) {
+					return ErrOverflow
+				}
+				if (0 <= a) != (0 <= b) && (0 <= diff) != (0 <= a) {
+					return ErrOverflow
+				}
+				in[j] = sum
+				in[j+half] = diff
+			}
+		}
+	}
+	return nil
+}
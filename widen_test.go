@@ -0,0 +1,149 @@
+package wht
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTransformWiden(t *testing.T) {
+	t.Run("8to16", func(tt *testing.T) {
+		x := make([]int8, 256)
+		for i := range x {
+			x[i] = 127
+		}
+		out := make([]int16, 256)
+		if err := Transform8To16(x, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if out[0] != 127*256 {
+			tt.Errorf("dc = %d", out[0])
+		}
+
+		y := make([]int8, 256)
+		if err := Invert16To8(out, y); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+	t.Run("16to32", func(tt *testing.T) {
+		x := []int16{32767, -32768, 32767, -32768, 32767, 32767, -32768, -32768}
+		out := make([]int32, len(x))
+		if err := Transform16To32(x, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int32{32767, -32768, 32767, -32768, 32767, 32767, -32768, -32768}
+		Transform(expect1)
+		if cmp.Equal(out, expect1) != true {
+			tt.Errorf("%v != %v", out, expect1)
+		}
+
+		y := make([]int16, len(x))
+		if err := Invert32To16(out, y); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+	t.Run("32to64", func(tt *testing.T) {
+		x := []int32{2147483647, 2147483647, 2147483647, 2147483647}
+		out := make([]int64, len(x))
+		if err := Transform32To64(x, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int64{4 * 2147483647, 0, 0, 0}
+		if cmp.Equal(out, expect1) != true {
+			tt.Errorf("%v != %v", out, expect1)
+		}
+
+		y := make([]int32, len(x))
+		if err := Invert64To32(out, y); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(x, y) != true {
+			tt.Errorf("%v != %v", x, y)
+		}
+	})
+	t.Run("overflow", func(tt *testing.T) {
+		// DC of 512 samples of -128 is -65536, which does not fit in int16
+		x := make([]int8, 512)
+		for i := range x {
+			x[i] = -128
+		}
+		if err := Transform8To16(x, make([]int16, 512)); errors.Is(err, ErrOverflow) != true {
+			tt.Errorf("expect ErrOverflow: %+v", err)
+		}
+
+		y := make([]int16, 1<<16)
+		for i := range y {
+			y[i] = -32768
+		}
+		out := make([]int32, 1<<16)
+		if err := Transform16To32(y, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if out[0] != -32768*65536 {
+			tt.Errorf("dc = %d", out[0])
+		}
+		if err := Transform16To32(make([]int16, 1<<17), make([]int32, 1<<17)); errors.Is(err, ErrOverflow) != true {
+			tt.Errorf("expect ErrOverflow: %+v", err)
+		}
+	})
+	t.Run("mismatch", func(tt *testing.T) {
+		if err := Transform16To32(make([]int16, 4), make([]int32, 8)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := Transform16To32(make([]int16, 3), make([]int32, 3)); errors.Is(err, ErrNotPowerOfTwo) != true {
+			tt.Errorf("expect ErrNotPowerOfTwo: %+v", err)
+		}
+	})
+}
+
+func TestTransformChecked(t *testing.T) {
+	t.Run("ok", func(tt *testing.T) {
+		x := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		if err := TransformChecked(x); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int16{4, 0, 0, 0, -2, 2, 2, 2}
+		if cmp.Equal(x, expect1) != true {
+			tt.Errorf("%v != %v", x, expect1)
+		}
+		if err := InvertChecked(x); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect2 := []int16{1, 0, 1, 0, 0, 1, 1, 0}
+		if cmp.Equal(x, expect2) != true {
+			tt.Errorf("%v != %v", x, expect2)
+		}
+	})
+	t.Run("overflow add", func(tt *testing.T) {
+		x := make([]int8, 16)
+		for i := range x {
+			x[i] = 10
+		}
+		if err := TransformChecked(x); errors.Is(err, ErrOverflow) != true {
+			tt.Errorf("expect ErrOverflow: %+v", err)
+		}
+	})
+	t.Run("overflow sub", func(tt *testing.T) {
+		x := []int8{0, -128}
+		if err := TransformChecked(x); errors.Is(err, ErrOverflow) != true {
+			tt.Errorf("expect ErrOverflow: %+v", err)
+		}
+	})
+	t.Run("boundary", func(tt *testing.T) {
+		x := []int8{-1, 127}
+		if err := TransformChecked(x); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int8{126, -128}
+		if cmp.Equal(x, expect) != true {
+			tt.Errorf("%v != %v", x, expect)
+		}
+	})
+}
@@ -11,7 +11,7 @@ func Zigzag[T Signed](matrix [][]T) []T {
 	for i := 0; i < maxN; i += 1 {
 		result[i] = matrix[row][col]
 
-		cursor(n, &row, &col, &goingUp)
+		cursor(n, n, &row, &col, &goingUp)
 	}
 	return result
 }
@@ -32,15 +32,15 @@ func Unzigzag[T Signed](data []T, stride int) [][]T {
 	for i := 0; i < maxN; i += 1 {
 		result[row][col] = data[i]
 
-		cursor(stride, &row, &col, &goingUp)
+		cursor(stride, stride, &row, &col, &goingUp)
 	}
 	return result
 }
 
-func cursor(n int, row, col *int, up *bool) {
+func cursor(rows, cols int, row, col *int, up *bool) {
 	if *up {
 		switch {
-		case *col == n-1:
+		case *col == cols-1:
 			*row += 1
 			*up = false
 		case *row == 0:
@@ -52,7 +52,7 @@ func cursor(n int, row, col *int, up *bool) {
 		}
 	} else {
 		switch {
-		case *row == n-1:
+		case *row == rows-1:
 			*col += 1
 			*up = true
 		case *col == 0:
@@ -64,3 +64,110 @@ func cursor(n int, row, col *int, up *bool) {
 		}
 	}
 }
+
+// UnzigzagE is Unzigzag that returns an error instead of nil when len(data) is not stride*stride.
+func UnzigzagE[T Signed](data []T, stride int) ([][]T, error) {
+	if stride < 1 {
+		return nil, ErrEmpty
+	}
+	if len(data) != stride*stride {
+		return nil, ErrLengthMismatch
+	}
+	return Unzigzag(data, stride), nil
+}
+
+// ZigzagRect scans a rows x cols matrix in zigzag order into out, which must have rows*cols elements.
+func ZigzagRect[T Signed](matrix [][]T, rows, cols int, out []T) error {
+	if err := checkRect(len(matrix), rows, cols, len(out)); err != nil {
+		return err
+	}
+	for i := 0; i < rows; i += 1 {
+		if len(matrix[i]) < cols {
+			return ErrLengthMismatch
+		}
+	}
+
+	row, col := 0, 0
+	goingUp := true
+	for i := range out {
+		out[i] = matrix[row][col]
+
+		cursor(rows, cols, &row, &col, &goingUp)
+	}
+	return nil
+}
+
+// UnzigzagRect restores a rows x cols matrix from data scanned by ZigzagRect into out.
+func UnzigzagRect[T Signed](data []T, rows, cols int, out [][]T) error {
+	if err := checkRect(len(out), rows, cols, len(data)); err != nil {
+		return err
+	}
+	for i := 0; i < rows; i += 1 {
+		if len(out[i]) < cols {
+			return ErrLengthMismatch
+		}
+	}
+
+	row, col := 0, 0
+	goingUp := true
+	for _, v := range data {
+		out[row][col] = v
+
+		cursor(rows, cols, &row, &col, &goingUp)
+	}
+	return nil
+}
+
+// ZigzagStrided scans a rows x cols block of a flat buffer whose rows are stride elements apart
+// in zigzag order into out, which must have rows*cols elements.
+func ZigzagStrided[T Signed](in []T, rows, cols, stride int, out []T) error {
+	if err := checkStrided(len(in), rows, cols, stride, len(out)); err != nil {
+		return err
+	}
+
+	row, col := 0, 0
+	goingUp := true
+	for i := range out {
+		out[i] = in[row*stride+col]
+
+		cursor(rows, cols, &row, &col, &goingUp)
+	}
+	return nil
+}
+
+// UnzigzagStrided restores a rows x cols block of a flat buffer whose rows are stride elements apart
+// from data scanned by ZigzagStrided.
+func UnzigzagStrided[T Signed](data []T, rows, cols, stride int, out []T) error {
+	if err := checkStrided(len(out), rows, cols, stride, len(data)); err != nil {
+		return err
+	}
+
+	row, col := 0, 0
+	goingUp := true
+	for _, v := range data {
+		out[row*stride+col] = v
+
+		cursor(rows, cols, &row, &col, &goingUp)
+	}
+	return nil
+}
+
+func checkRect(numRows, rows, cols, size int) error {
+	if rows < 1 || cols < 1 {
+		return ErrEmpty
+	}
+	if numRows < rows || size != rows*cols {
+		return ErrLengthMismatch
+	}
+	return nil
+}
+
+func checkStrided(bufSize, rows, cols, stride, size int) error {
+	if rows < 1 || cols < 1 {
+		return ErrEmpty
+	}
+	if stride < cols || bufSize < (rows-1)*stride+cols || size != rows*cols {
+		return ErrLengthMismatch
+	}
+	return nil
+}
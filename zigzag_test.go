@@ -1,6 +1,7 @@
 package wht
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -39,3 +40,147 @@ func TestZigzag(t *testing.T) {
 		}
 	})
 }
+
+func TestUnzigzagE(t *testing.T) {
+	t.Run("valid", func(tt *testing.T) {
+		m, err := UnzigzagE([]int16{0, 1, 2, 3}, 2)
+		if err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := [][]int16{
+			{0, 1},
+			{2, 3},
+		}
+		if cmp.Equal(m, expect) != true {
+			tt.Errorf("%v != %v", m, expect)
+		}
+	})
+	t.Run("mismatch", func(tt *testing.T) {
+		if _, err := UnzigzagE([]int16{0, 1, 2}, 2); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if _, err := UnzigzagE([]int16{}, 0); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
+
+func TestZigzagRect(t *testing.T) {
+	t.Run("8x7", func(tt *testing.T) {
+		// same scan as table8x7 of _example/imagecompress-10
+		matrix := make([][]int16, 8)
+		for r := range matrix {
+			matrix[r] = make([]int16, 7)
+			for c := range matrix[r] {
+				matrix[r][c] = int16(r*10 + c)
+			}
+		}
+		out := make([]int16, 8*7)
+		if err := ZigzagRect(matrix, 8, 7, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int16{
+			0, 1, 10, 20, 11, 2, 3, 12,
+			21, 30, 40, 31, 22, 13, 4, 5,
+			14, 23, 32, 41, 50, 60, 51, 42,
+			33, 24, 15, 6, 16, 25, 34, 43,
+			52, 61, 70, 71, 62, 53, 44, 35,
+			26, 36, 45, 54, 63, 72, 73, 64,
+			55, 46, 56, 65, 74, 75, 66, 76,
+		}
+		if cmp.Equal(out, expect1) != true {
+			tt.Errorf("%v != %v", out, expect1)
+		}
+
+		restored := make([][]int16, 8)
+		for r := range restored {
+			restored[r] = make([]int16, 7)
+		}
+		if err := UnzigzagRect(out, 8, 7, restored); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(restored, matrix) != true {
+			tt.Errorf("%v != %v", restored, matrix)
+		}
+	})
+	t.Run("square", func(tt *testing.T) {
+		matrix := [][]int16{
+			{0, 1, 5, 6},
+			{2, 4, 7, 12},
+			{3, 8, 11, 13},
+			{9, 10, 14, 15},
+		}
+		out := make([]int16, 16)
+		if err := ZigzagRect(matrix, 4, 4, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := Zigzag(matrix)
+		if cmp.Equal(out, expect) != true {
+			tt.Errorf("%v != %v", out, expect)
+		}
+	})
+	t.Run("wide", func(tt *testing.T) {
+		matrix := [][]int16{
+			{0, 1, 5, 6, 9},
+			{2, 4, 7, 8, 10},
+		}
+		out := make([]int16, 10)
+		if err := ZigzagRect(matrix, 2, 5, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect := []int16{0, 1, 2, 4, 5, 6, 7, 8, 9, 10}
+		if cmp.Equal(out, expect) != true {
+			tt.Errorf("%v != %v", out, expect)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		matrix := [][]int16{{1, 2}, {3, 4}}
+		if err := ZigzagRect(matrix, 2, 2, make([]int16, 3)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := ZigzagRect(matrix, 2, 3, make([]int16, 6)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := UnzigzagRect([]int16{}, 0, 2, matrix); errors.Is(err, ErrEmpty) != true {
+			tt.Errorf("expect ErrEmpty: %+v", err)
+		}
+	})
+}
+
+func TestZigzagStrided(t *testing.T) {
+	t.Run("3x2", func(tt *testing.T) {
+		in := []int16{
+			0, 1, -1, -1,
+			2, 4, -1, -1,
+			3, 5,
+		}
+		out := make([]int16, 6)
+		if err := ZigzagStrided(in, 3, 2, 4, out); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		expect1 := []int16{0, 1, 2, 3, 4, 5}
+		if cmp.Equal(out, expect1) != true {
+			tt.Errorf("%v != %v", out, expect1)
+		}
+
+		restored := []int16{
+			-1, -1, -1, -1,
+			-1, -1, -1, -1,
+			-1, -1,
+		}
+		if err := UnzigzagStrided(out, 3, 2, 4, restored); err != nil {
+			tt.Fatalf("no error: %+v", err)
+		}
+		if cmp.Equal(restored, in) != true {
+			tt.Errorf("%v != %v", restored, in)
+		}
+	})
+	t.Run("invalid", func(tt *testing.T) {
+		if err := ZigzagStrided(make([]int16, 9), 3, 2, 4, make([]int16, 6)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+		if err := ZigzagStrided(make([]int16, 16), 3, 4, 2, make([]int16, 12)); errors.Is(err, ErrLengthMismatch) != true {
+			tt.Errorf("expect ErrLengthMismatch: %+v", err)
+		}
+	})
+}